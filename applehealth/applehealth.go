@@ -0,0 +1,259 @@
+// Package applehealth converts parsed Cronometer exports into Apple Health records. The records are written in the
+// format of the export.xml file produced by the Health app, which is the format understood by the apps that import
+// data into Apple Health.
+package applehealth
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFormat is the format of the dates in Apple Health records.
+const DateFormat = "2006-01-02 15:04:05 -0700"
+
+// DefaultSourceName is the source name given to records when one is not provided.
+const DefaultSourceName = "Cronometer"
+
+// Record is a single Apple Health quantity sample.
+type Record struct {
+	Type       string
+	SourceName string
+	Unit       string
+	StartDate  time.Time
+	EndDate    time.Time
+	Value      float64
+}
+
+// MarshalXML encodes the record using the date and number formats of Apple Health.
+func (r Record) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "Record"
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "type"}, Value: r.Type},
+		{Name: xml.Name{Local: "sourceName"}, Value: r.SourceName},
+		{Name: xml.Name{Local: "unit"}, Value: r.Unit},
+		{Name: xml.Name{Local: "startDate"}, Value: r.StartDate.Format(DateFormat)},
+		{Name: xml.Name{Local: "endDate"}, Value: r.EndDate.Format(DateFormat)},
+		{Name: xml.Name{Local: "value"}, Value: strconv.FormatFloat(r.Value, 'f', -1, 64)},
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// biometricType describes how a Cronometer metric maps to an Apple Health type.
+type biometricType struct {
+	identifier string
+	units      map[string]string // Cronometer unit to Apple Health unit.
+	scale      float64           // Multiplier applied to the Cronometer amount.
+}
+
+// biometricTypes maps the Cronometer metric names to Apple Health types. Metrics are matched without regard to case.
+var biometricTypes = map[string]biometricType{
+	"weight": {
+		identifier: "HKQuantityTypeIdentifierBodyMass",
+		units:      map[string]string{"kg": "kg", "lbs": "lb", "lb": "lb"},
+		scale:      1,
+	},
+	"heart rate": {
+		identifier: "HKQuantityTypeIdentifierHeartRate",
+		units:      map[string]string{"bpm": "count/min"},
+		scale:      1,
+	},
+	"body fat": {
+		identifier: "HKQuantityTypeIdentifierBodyFatPercentage",
+		units:      map[string]string{"%": "%"},
+		scale:      0.01,
+	},
+	"blood glucose": {
+		identifier: "HKQuantityTypeIdentifierBloodGlucose",
+		units:      map[string]string{"mg/dl": "mg/dL", "mmol/l": "mmol<180.1558800000541>/L"},
+		scale:      1,
+	},
+	"height": {
+		identifier: "HKQuantityTypeIdentifierHeight",
+		units:      map[string]string{"cm": "cm", "in": "in"},
+		scale:      1,
+	},
+}
+
+// FromBiometrics converts the biometric records to Apple Health records. Records with metrics or units that have no
+// Apple Health equivalent are returned in skipped. Blood pressure is exported by Cronometer as "systolic/diastolic"
+// and is only converted when the records were parsed with gocronometer.WithKeepRaw, as the amount is otherwise lost.
+func FromBiometrics(records gocronometer.BiometricRecords, sourceName string) (converted []Record, skipped gocronometer.BiometricRecords) {
+	if sourceName == "" {
+		sourceName = DefaultSourceName
+	}
+
+	for _, b := range records {
+		metric := strings.ToLower(strings.TrimSpace(b.Metric))
+
+		if metric == "blood pressure" {
+			bp, ok := bloodPressure(b, sourceName)
+			if !ok {
+				skipped = append(skipped, b)
+				continue
+			}
+			converted = append(converted, bp...)
+			continue
+		}
+
+		t, ok := biometricTypes[metric]
+		if !ok {
+			skipped = append(skipped, b)
+			continue
+		}
+
+		unit, ok := t.units[strings.ToLower(b.Unit)]
+		if !ok {
+			skipped = append(skipped, b)
+			continue
+		}
+
+		converted = append(converted, Record{
+			Type:       t.identifier,
+			SourceName: sourceName,
+			Unit:       unit,
+			StartDate:  b.RecordedTime,
+			EndDate:    b.RecordedTime,
+			Value:      b.Amount * t.scale,
+		})
+	}
+
+	return converted, skipped
+}
+
+// bloodPressure converts the raw systolic/diastolic amount of the record.
+func bloodPressure(b gocronometer.BiometricRecord, sourceName string) ([]Record, bool) {
+	if b.Raw == nil {
+		return nil, false
+	}
+
+	amount, ok := b.Raw.Get("Amount")
+	if !ok {
+		return nil, false
+	}
+
+	parts := strings.SplitN(amount, "/", 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	systolic, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return nil, false
+	}
+	diastolic, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return []Record{
+		{Type: "HKQuantityTypeIdentifierBloodPressureSystolic", SourceName: sourceName, Unit: "mmHg",
+			StartDate: b.RecordedTime, EndDate: b.RecordedTime, Value: systolic},
+		{Type: "HKQuantityTypeIdentifierBloodPressureDiastolic", SourceName: sourceName, Unit: "mmHg",
+			StartDate: b.RecordedTime, EndDate: b.RecordedTime, Value: diastolic},
+	}, true
+}
+
+// dietaryTypes maps the nutrients of a serving to the Apple Health dietary types.
+var dietaryTypes = []struct {
+	identifier string
+	unit       string
+	value      func(s gocronometer.ServingRecord) float64
+}{
+	{"HKQuantityTypeIdentifierDietaryEnergyConsumed", "kcal", func(s gocronometer.ServingRecord) float64 { return s.EnergyKcal }},
+	{"HKQuantityTypeIdentifierDietaryProtein", "g", func(s gocronometer.ServingRecord) float64 { return s.ProteinG }},
+	{"HKQuantityTypeIdentifierDietaryCarbohydrates", "g", func(s gocronometer.ServingRecord) float64 { return s.CarbsG }},
+	{"HKQuantityTypeIdentifierDietaryFatTotal", "g", func(s gocronometer.ServingRecord) float64 { return s.FatG }},
+	{"HKQuantityTypeIdentifierDietaryFatSaturated", "g", func(s gocronometer.ServingRecord) float64 { return s.SaturatedG }},
+	{"HKQuantityTypeIdentifierDietaryFiber", "g", func(s gocronometer.ServingRecord) float64 { return s.FiberG }},
+	{"HKQuantityTypeIdentifierDietarySugar", "g", func(s gocronometer.ServingRecord) float64 { return s.SugarsG }},
+	{"HKQuantityTypeIdentifierDietaryCholesterol", "mg", func(s gocronometer.ServingRecord) float64 { return s.CholesterolMg }},
+	{"HKQuantityTypeIdentifierDietarySodium", "mg", func(s gocronometer.ServingRecord) float64 { return s.SodiumMg }},
+	{"HKQuantityTypeIdentifierDietaryPotassium", "mg", func(s gocronometer.ServingRecord) float64 { return s.PotassiumMg }},
+	{"HKQuantityTypeIdentifierDietaryCalcium", "mg", func(s gocronometer.ServingRecord) float64 { return s.CalciumMg }},
+	{"HKQuantityTypeIdentifierDietaryIron", "mg", func(s gocronometer.ServingRecord) float64 { return s.IronMg }},
+	{"HKQuantityTypeIdentifierDietaryVitaminC", "mg", func(s gocronometer.ServingRecord) float64 { return s.VitaminCMg }},
+	{"HKQuantityTypeIdentifierDietaryVitaminD", "mcg", func(s gocronometer.ServingRecord) float64 { return s.VitaminDUg() }},
+	{"HKQuantityTypeIdentifierDietaryCaffeine", "mg", func(s gocronometer.ServingRecord) float64 { return s.CaffeineMg }},
+	// A gram of water is treated as a milliliter.
+	{"HKQuantityTypeIdentifierDietaryWater", "mL", func(s gocronometer.ServingRecord) float64 { return s.WaterG }},
+}
+
+// FromDailyNutrition converts the servings into daily nutrition totals as Apple Health dietary records. Each record
+// spans the day of the servings in the location provided, with a nil location treated as UTC. Nutrients with a daily
+// total of zero are omitted.
+func FromDailyNutrition(servings gocronometer.ServingRecords, location *time.Location, sourceName string) []Record {
+	if sourceName == "" {
+		sourceName = DefaultSourceName
+	}
+
+	days := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) gocronometer.Date {
+		return s.Day
+	})
+
+	ordered := make([]gocronometer.Date, 0, len(days))
+	for day := range days {
+		ordered = append(ordered, day)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Before(ordered[j])
+	})
+
+	var records []Record
+	for _, day := range ordered {
+		total := days[day].Total()
+		start := day.Time(location)
+		end := day.AddDays(1).Time(location).Add(-time.Second)
+
+		for _, t := range dietaryTypes {
+			v := t.value(total)
+			if v == 0 {
+				continue
+			}
+			records = append(records, Record{
+				Type:       t.identifier,
+				SourceName: sourceName,
+				Unit:       t.unit,
+				StartDate:  start,
+				EndDate:    end,
+				Value:      v,
+			})
+		}
+	}
+
+	return records
+}
+
+// Write writes the records to w as an Apple Health export document.
+func Write(w io.Writer, records []Record) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("writing xml header: %s", err)
+	}
+
+	doc := struct {
+		XMLName xml.Name `xml:"HealthData"`
+		Locale  string   `xml:"locale,attr"`
+		Records []Record `xml:"Record"`
+	}{
+		Locale:  "en_US",
+		Records: records,
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", " ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("encoding apple health records: %s", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,62 @@
+package applehealth_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/applehealth"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromBiometrics(t *testing.T) {
+	raw := "Day,Time,Metric,Unit,Amount\n" +
+		"2021-06-01,07:00,Weight,lbs,180.5\n" +
+		"2021-06-01,07:05,Blood Pressure,mmHg,120/80\n" +
+		"2021-06-01,07:10,Mood,,7\n"
+
+	records, err := gocronometer.ParseBiometrics(strings.NewReader(raw), gocronometer.WithKeepRaw())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	converted, skipped := applehealth.FromBiometrics(records, "")
+	if len(skipped) != 1 || skipped[0].Metric != "Mood" {
+		t.Fatalf("expected mood to be skipped but found %+v", skipped)
+	}
+
+	if len(converted) != 3 {
+		t.Fatalf("expected 3 records but found %d", len(converted))
+	}
+
+	if converted[0].Type != "HKQuantityTypeIdentifierBodyMass" || converted[0].Unit != "lb" || converted[0].Value != 180.5 {
+		t.Fatalf("unexpected weight record: %+v", converted[0])
+	}
+
+	if converted[1].Value != 120 || converted[2].Value != 80 {
+		t.Fatalf("unexpected blood pressure records: %+v", converted[1:])
+	}
+}
+
+func TestWrite(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: gocronometer.Date{Year: 2021, Month: time.June, Day: 1}, EnergyKcal: 100, ProteinG: 5},
+		{Day: gocronometer.Date{Year: 2021, Month: time.June, Day: 1}, EnergyKcal: 50},
+	}
+
+	records := applehealth.FromDailyNutrition(servings, time.UTC, "")
+	if len(records) != 2 {
+		t.Fatalf("expected energy and protein records but found %+v", records)
+	}
+
+	var buf bytes.Buffer
+	if err := applehealth.Write(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<Record type="HKQuantityTypeIdentifierDietaryEnergyConsumed" sourceName="Cronometer" unit="kcal" ` +
+		`startDate="2021-06-01 00:00:00 +0000" endDate="2021-06-01 23:59:59 +0000" value="150"></Record>`
+	if !strings.Contains(buf.String(), expected) {
+		t.Fatalf("expected the document to contain %s but found %s", expected, buf.String())
+	}
+}
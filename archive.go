@@ -0,0 +1,155 @@
+package gocronometer
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Export holds the records of several Cronometer exports, such as those of the "Export All Data" archive.
+type Export struct {
+	// Kind is the type of the export read by Parse. It is ExportTypeUnknown for archives holding several exports.
+	Kind ExportType
+
+	Servings   ServingRecords
+	Exercises  ExerciseRecords
+	Biometrics BiometricRecords
+	Notes      NoteRecords
+
+	// DailyNutrition holds the days of the daily nutrition export.
+	DailyNutrition DailyNutritionRecords
+}
+
+// archiveFiles maps the names of the files in the archive, without the .csv extension, to the parser of the file.
+var archiveFiles = map[string]func(e *Export, r io.Reader, options []ParseOption) error{
+	"servings": func(e *Export, r io.Reader, options []ParseOption) error {
+		records, err := ParseServings(r, options...)
+		e.Servings = append(e.Servings, records...)
+		return err
+	},
+	"exercises": func(e *Export, r io.Reader, options []ParseOption) error {
+		records, err := ParseExercises(r, options...)
+		e.Exercises = append(e.Exercises, records...)
+		return err
+	},
+	"biometrics": func(e *Export, r io.Reader, options []ParseOption) error {
+		records, err := ParseBiometrics(r, options...)
+		e.Biometrics = append(e.Biometrics, records...)
+		return err
+	},
+	"notes": func(e *Export, r io.Reader, options []ParseOption) error {
+		records, err := ParseNotes(r, options...)
+		e.Notes = append(e.Notes, records...)
+		return err
+	},
+	"dailySummary": func(e *Export, r io.Reader, options []ParseOption) error {
+		records, err := ParseDailyNutrition(r, options...)
+		e.DailyNutrition = append(e.DailyNutrition, records...)
+		return err
+	},
+}
+
+// ParseArchive parses the servings, exercises, biometrics, notes and daily nutrition of the ZIP archive produced by the "Export All
+// Data" option of Cronometer. The CSV files are recognized by their name, such as servings.csv, in any directory of
+// the archive, or by their headers when they are named otherwise. Other files are ignored. The options are applied to
+// every file parsed. In lenient mode the records of every file are returned along with the errors of the rows that
+// failed.
+func ParseArchive(r io.ReaderAt, size int64, options ...ParseOption) (Export, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return Export{}, fmt.Errorf("reading archive: %w", err)
+	}
+
+	return parseArchive(zr, options)
+}
+
+// ParseArchiveFile parses the archive stored at the path. See ParseArchive.
+func ParseArchiveFile(path string, options ...ParseOption) (Export, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return Export{}, fmt.Errorf("opening archive: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer zr.Close()
+
+	return parseArchive(&zr.Reader, options)
+}
+
+// parseArchive parses every recognized file of the archive.
+func parseArchive(zr *zip.Reader, options []ParseOption) (Export, error) {
+	var (
+		export  Export
+		rowErrs RowErrors
+		opts    = newParseOptions(options...)
+	)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !isCSV(f.Name) {
+			continue
+		}
+
+		data, err := readArchiveFile(f, opts)
+		if err != nil {
+			return Export{}, err
+		}
+
+		// Files that are not named after their type are detected from their headers.
+		parse, ok := archiveFiles[archiveFileKind(f.Name)]
+		if !ok {
+			detected, err := DetectExportType(bytes.NewReader(data))
+			if err != nil {
+				continue
+			}
+			parse, ok = archiveFiles[detected.String()]
+			if !ok {
+				continue
+			}
+		}
+
+		err = parse(&export, bytes.NewReader(data), options)
+
+		if errs, ok := err.(RowErrors); ok {
+			rowErrs = append(rowErrs, errs...)
+			continue
+		}
+		if err != nil {
+			return Export{}, fmt.Errorf("parsing %s: %w", f.Name, err)
+		}
+	}
+
+	if len(rowErrs) > 0 {
+		return export, rowErrs
+	}
+	return export, nil
+}
+
+// readArchiveFile reads the contents of the file, up to the MaxBytes of the options.
+func readArchiveFile(f *zip.File, opts *ParseOptions) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", f.Name, err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer rc.Close()
+
+	data, err := io.ReadAll(opts.limitReader(rc))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", f.Name, err)
+	}
+	return data, nil
+}
+
+// isCSV returns true if the name has a .csv or .csv.gz extension.
+func isCSV(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasSuffix(name, ".csv") || strings.HasSuffix(name, ".csv.gz")
+}
+
+// archiveFileKind returns the lower case base name of the file without its .csv or .csv.gz extension.
+func archiveFileKind(name string) string {
+	base := strings.ToLower(path.Base(name))
+	base = strings.TrimSuffix(base, ".gz")
+	return strings.TrimSuffix(base, ".csv")
+}
@@ -0,0 +1,46 @@
+package gocronometer_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestParseArchive(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"cronometer/servings.csv":     "Day,Time,Food Name,Energy (kcal)\n2021-06-01,08:00,Eggs,140\n",
+		"cronometer/exercises.csv":    "Day,Time,Exercise,Minutes,Calories Burned\n2021-06-01,18:00,Walking,30,120\n",
+		"cronometer/biometrics.csv":   "Day,Time,Metric,Unit,Amount\n2021-06-01,07:00,Weight,kg,80\n",
+		"cronometer/journal.csv":      "Day,Group,Note\n2021-06-01,,Rest day\n",
+		"cronometer/dailysummary.csv": "Date,Energy (kcal),Completed\n2021-06-01,2000,false\n",
+		"cronometer/readme.txt":       "ignored",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := gocronometer.ParseArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(export.Servings) != 1 || len(export.Exercises) != 1 || len(export.Biometrics) != 1 || len(export.Notes) != 1 ||
+		len(export.DailyNutrition) != 1 {
+		t.Fatalf("expected a record of each type but found %+v", export)
+	}
+
+	if export.Notes[0].Note != "Rest day" || export.Exercises[0].CaloriesBurned != 120 {
+		t.Fatalf("unexpected export: %+v", export)
+	}
+}
@@ -0,0 +1,70 @@
+package gocronometer
+
+import (
+	"math"
+	"sort"
+)
+
+// EnergyBalance is the energy eaten less the energy burned by exercise over a period of days.
+type EnergyBalance struct {
+	// Start and End are the first and last days of the period. They are the same day for daily balances.
+	Start Date
+	End   Date
+
+	IntakeKcal float64
+	BurnedKcal float64
+}
+
+// BalanceKcal returns the energy eaten less the energy burned. A negative balance is a deficit.
+func (b EnergyBalance) BalanceKcal() float64 {
+	return b.IntakeKcal - b.BurnedKcal
+}
+
+// DailyEnergyBalance returns the energy balance of each day with servings or exercises, earliest first. The energy
+// burned is the sum of the calories burned by the exercises of the day, regardless of the sign they are exported with.
+// The energy burned by the basal metabolism is not exported by Cronometer so it is not included, and must be
+// subtracted by the caller to find the true balance.
+func DailyEnergyBalance(servings ServingRecords, exercises ExerciseRecords) []EnergyBalance {
+	return energyBalance(servings, exercises, func(d Date) (Date, Date) { return d, d })
+}
+
+// WeeklyEnergyBalance returns the energy balance of each week, starting on Monday, with servings or exercises,
+// earliest first. The balances are calculated in the same way as DailyEnergyBalance.
+func WeeklyEnergyBalance(servings ServingRecords, exercises ExerciseRecords) []EnergyBalance {
+	return energyBalance(servings, exercises, func(d Date) (Date, Date) {
+		// Weekday counts from Sunday so shift it to count from Monday.
+		start := d.AddDays(-((int(d.Time(nil).Weekday()) + 6) % 7))
+		return start, start.AddDays(6)
+	})
+}
+
+// energyBalance sums the balance of the periods returned by period for each day.
+func energyBalance(servings ServingRecords, exercises ExerciseRecords, period func(Date) (Date, Date)) []EnergyBalance {
+	balances := make(map[Date]*EnergyBalance)
+	get := func(d Date) *EnergyBalance {
+		start, end := period(d)
+		if b, ok := balances[start]; ok {
+			return b
+		}
+		b := &EnergyBalance{Start: start, End: end}
+		balances[start] = b
+		return b
+	}
+
+	for _, s := range servings {
+		get(s.Day).IntakeKcal += s.EnergyKcal
+	}
+	for _, e := range exercises {
+		get(e.Day).BurnedKcal += math.Abs(e.CaloriesBurned)
+	}
+
+	result := make([]EnergyBalance, 0, len(balances))
+	for _, b := range balances {
+		result = append(result, *b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result
+}
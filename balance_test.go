@@ -0,0 +1,52 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestDailyEnergyBalance(t *testing.T) {
+	monday := gocronometer.Date{Year: 2021, Month: time.May, Day: 31}
+	servings := gocronometer.ServingRecords{
+		{Day: monday, EnergyKcal: 1500},
+		{Day: monday, EnergyKcal: 700},
+		{Day: monday.AddDays(2), EnergyKcal: 1800},
+	}
+	exercises := gocronometer.ExerciseRecords{
+		{Day: monday, CaloriesBurned: -300},
+		{Day: monday.AddDays(1), CaloriesBurned: 250},
+	}
+
+	daily := gocronometer.DailyEnergyBalance(servings, exercises)
+	if len(daily) != 3 {
+		t.Fatalf("expected 3 days but found %+v", daily)
+	}
+	if daily[0].Start != monday || daily[0].End != monday || daily[0].IntakeKcal != 2200 || daily[0].BurnedKcal != 300 || daily[0].BalanceKcal() != 1900 {
+		t.Fatalf("unexpected balance of the first day: %+v", daily[0])
+	}
+	if daily[1].BalanceKcal() != -250 {
+		t.Fatalf("expected a deficit of 250 kcal on the second day but found %+v", daily[1])
+	}
+}
+
+func TestWeeklyEnergyBalance(t *testing.T) {
+	monday := gocronometer.Date{Year: 2021, Month: time.May, Day: 31}
+	servings := gocronometer.ServingRecords{
+		{Day: monday.AddDays(-1), EnergyKcal: 2000},
+		{Day: monday, EnergyKcal: 1500},
+		{Day: monday.AddDays(6), EnergyKcal: 1800},
+	}
+	exercises := gocronometer.ExerciseRecords{{Day: monday.AddDays(3), CaloriesBurned: -400}}
+
+	weekly := gocronometer.WeeklyEnergyBalance(servings, exercises)
+	if len(weekly) != 2 {
+		t.Fatalf("expected 2 weeks but found %+v", weekly)
+	}
+	if weekly[1].Start != monday || weekly[1].End != monday.AddDays(6) || weekly[1].BalanceKcal() != 2900 {
+		t.Fatalf("unexpected balance of the week: %+v", weekly[1])
+	}
+	if weekly[0].Start != monday.AddDays(-7) || weekly[0].IntakeKcal != 2000 {
+		t.Fatalf("unexpected balance of the previous week: %+v", weekly[0])
+	}
+}
@@ -0,0 +1,124 @@
+package gocronometer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ExportCache stores raw exports so repeated exports of the same date range don't hit Cronometer. The client only
+// caches exports of ranges ending before the current day, as days that are complete rarely change. Implementations
+// must be safe for concurrent use.
+type ExportCache interface {
+	// Get returns the cached export for the key, or false if it is not cached.
+	Get(key ExportCacheKey) (string, bool)
+
+	// Set stores the export for the key.
+	Set(key ExportCacheKey, export string)
+}
+
+// ExportCacheKey identifies a cached export by its type and date range.
+type ExportCacheKey struct {
+	// Type is the type of the export as requested from Cronometer, for example "servings".
+	Type  string
+	Start Date
+	End   Date
+
+	// UserID is the logged in user the export was made for, so the exports of different accounts sharing a cache are
+	// kept apart. It is empty when no user is logged in.
+	UserID string
+}
+
+// String formats the key as "type_start_end", which is safe to use as a file name. Keys with a UserID are prefixed
+// with it as "userid_type_start_end".
+func (k ExportCacheKey) String() string {
+	if k.UserID != "" {
+		return fmt.Sprintf("%s_%s_%s_%s", k.UserID, k.Type, k.Start, k.End)
+	}
+	return fmt.Sprintf("%s_%s_%s", k.Type, k.Start, k.End)
+}
+
+// complete returns true if the range ends before the current day in the location.
+func (k ExportCacheKey) complete(location *time.Location) bool {
+	return k.End.Before(DateOf(time.Now().In(location)))
+}
+
+// MemoryExportCache is an ExportCache holding the exports in memory.
+type MemoryExportCache struct {
+	mu      sync.RWMutex
+	exports map[ExportCacheKey]string
+}
+
+// NewMemoryExportCache creates an empty MemoryExportCache.
+func NewMemoryExportCache() *MemoryExportCache {
+	return &MemoryExportCache{exports: make(map[ExportCacheKey]string)}
+}
+
+// Get implements ExportCache.
+func (m *MemoryExportCache) Get(key ExportCacheKey) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	export, ok := m.exports[key]
+	return export, ok
+}
+
+// Set implements ExportCache.
+func (m *MemoryExportCache) Set(key ExportCacheKey, export string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.exports[key] = export
+}
+
+// DiskExportCache is an ExportCache storing each export as a CSV file in a directory, so the cache survives restarts.
+// Failures to read or write the files are treated as cache misses so they never fail an export.
+type DiskExportCache struct {
+	dir string
+}
+
+// NewDiskExportCache creates a DiskExportCache in the directory, creating the directory if it does not exist.
+func NewDiskExportCache(dir string) (*DiskExportCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating export cache directory: %w", err)
+	}
+	return &DiskExportCache{dir: dir}, nil
+}
+
+// path returns the path of the file of the key.
+func (d *DiskExportCache) path(key ExportCacheKey) string {
+	return filepath.Join(d.dir, key.String()+".csv")
+}
+
+// Get implements ExportCache.
+func (d *DiskExportCache) Get(key ExportCacheKey) (string, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// Set implements ExportCache. The file is written to a temporary file first so concurrent reads never see a partial
+// export.
+func (d *DiskExportCache) Set(key ExportCacheKey, export string) {
+	f, err := os.CreateTemp(d.dir, key.String()+".*.tmp")
+	if err != nil {
+		return
+	}
+
+	_, err = f.WriteString(export)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return
+	}
+
+	if err := os.Rename(f.Name(), d.path(key)); err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,90 @@
+package gocronometer_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/gocronometertest"
+	"testing"
+	"time"
+)
+
+func TestDiskExportCache(t *testing.T) {
+	cache, err := gocronometer.NewDiskExportCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := gocronometer.ExportCacheKey{
+		Type:  "servings",
+		Start: gocronometer.Date{Year: 2021, Month: time.June, Day: 1},
+		End:   gocronometer.Date{Year: 2021, Month: time.June, Day: 30},
+	}
+	if _, ok := cache.Get(key); ok {
+		t.Fatal("expected an empty cache")
+	}
+
+	cache.Set(key, "Day,Time\n")
+	if export, ok := cache.Get(key); !ok || export != "Day,Time\n" {
+		t.Fatalf("expected the export to be cached but found %q", export)
+	}
+}
+
+func TestClient_ExportCache(t *testing.T) {
+	cache := gocronometer.NewMemoryExportCache()
+	cache.Set(gocronometer.ExportCacheKey{
+		Type:  "biometrics",
+		Start: gocronometer.Date{Year: 2021, Month: time.June, Day: 1},
+		End:   gocronometer.Date{Year: 2021, Month: time.June, Day: 2},
+	}, "Day,Time,Metric,Unit,Amount\n2021-06-01,,Weight,kg,80\n")
+
+	// The client is not logged in so the export can only succeed from the cache.
+	client := gocronometer.NewClient(&gocronometer.ClientOptions{ExportCache: cache})
+	records, err := client.ExportBiometricRecordsParsedWithLocation(context.Background(),
+		time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 1 || records[0].Amount != 80 {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+}
+
+func TestClient_ExportCache_UserID(t *testing.T) {
+	ctx := context.Background()
+	server := gocronometertest.NewServer("user", "pass")
+	defer server.Close()
+	server.SetExport("biometrics", "Day,Time,Metric,Unit,Amount\n2021-06-01,,Weight,kg,80\n")
+
+	cache := gocronometer.NewMemoryExportCache()
+	client := server.Client(&gocronometer.ClientOptions{ExportCache: cache})
+	if err := client.Login(ctx, "user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	start, end := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := client.ExportBiometrics(ctx, start, end); err != nil {
+		t.Fatal(err)
+	}
+
+	// Exports are cached for the logged in user, so accounts sharing the cache do not read each other's exports.
+	key := gocronometer.ExportCacheKey{Type: "biometrics", Start: gocronometer.DateOf(start), End: gocronometer.DateOf(end)}
+	if _, ok := cache.Get(key); ok {
+		t.Fatal("expected no export cached without a user")
+	}
+	key.UserID = gocronometertest.UserID
+	if _, ok := cache.Get(key); !ok {
+		t.Fatalf("expected the export of user %s to be cached", key.UserID)
+	}
+}
+
+func TestExportCacheKey_String(t *testing.T) {
+	key := gocronometer.ExportCacheKey{Type: "servings", Start: gocronometer.Date{Year: 2021, Month: time.June, Day: 1}, End: gocronometer.Date{Year: 2021, Month: time.June, Day: 30}}
+	if key.String() != "servings_2021-06-01_2021-06-30" {
+		t.Fatalf("unexpected key: %s", key)
+	}
+
+	key.UserID = "1234567"
+	if key.String() != "1234567_servings_2021-06-01_2021-06-30" {
+		t.Fatalf("unexpected key: %s", key)
+	}
+}
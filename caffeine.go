@@ -0,0 +1,58 @@
+package gocronometer
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultCaffeineHalfLife is a typical half-life of caffeine in healthy adults. It varies widely between people, from
+// under three hours to over seven, and is longer during pregnancy or with some medications.
+const DefaultCaffeineHalfLife = 5 * time.Hour
+
+// CaffeinePoint is the caffeine estimated to remain at a time.
+type CaffeinePoint struct {
+	Time time.Time
+	Mg   float64
+}
+
+// CaffeineAt estimates the caffeine in mg remaining at t from the servings consumed before it, decaying each serving
+// exponentially with the half-life provided. Caffeine is assumed to be absorbed as soon as it is consumed. Servings
+// without a time are ignored, as the default time they are given says nothing of when they were consumed. A half-life
+// of zero uses DefaultCaffeineHalfLife.
+func (s ServingRecords) CaffeineAt(t time.Time, halfLife time.Duration) float64 {
+	if halfLife <= 0 {
+		halfLife = DefaultCaffeineHalfLife
+	}
+
+	var remaining float64
+	for _, serving := range s {
+		if !serving.HasTime || serving.CaffeineMg == 0 || serving.RecordedTime.After(t) {
+			continue
+		}
+		elapsed := t.Sub(serving.RecordedTime)
+		remaining += serving.CaffeineMg * math.Pow(0.5, float64(elapsed)/float64(halfLife))
+	}
+
+	return remaining
+}
+
+// CaffeineCurve estimates the caffeine remaining at every step from start to end, inclusive, to chart the caffeine
+// of a day. The estimates are calculated as CaffeineAt. A step of zero or less returns the points of start and end.
+//
+//	bedtime := time.Date(2021, 6, 1, 23, 0, 0, 0, time.Local)
+//	fmt.Printf("%.0f mg at bedtime\n", servings.CaffeineAt(bedtime, gocronometer.DefaultCaffeineHalfLife))
+func (s ServingRecords) CaffeineCurve(start time.Time, end time.Time, step time.Duration, halfLife time.Duration) []CaffeinePoint {
+	if step <= 0 {
+		step = end.Sub(start)
+	}
+
+	points := make([]CaffeinePoint, 0)
+	for t := start; !t.After(end); t = t.Add(step) {
+		points = append(points, CaffeinePoint{Time: t, Mg: s.CaffeineAt(t, halfLife)})
+		if step <= 0 {
+			break
+		}
+	}
+
+	return points
+}
@@ -0,0 +1,43 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestServingRecords_CaffeineAt(t *testing.T) {
+	morning := time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)
+	servings := gocronometer.ServingRecords{
+		{RecordedTime: morning, HasTime: true, FoodName: "Coffee", CaffeineMg: 200},
+		{RecordedTime: morning.Add(5 * time.Hour), HasTime: true, FoodName: "Tea", CaffeineMg: 50},
+		{RecordedTime: morning.Add(-8 * time.Hour), FoodName: "Cola", CaffeineMg: 40},
+	}
+
+	if mg := servings.CaffeineAt(morning.Add(-time.Minute), 0); mg != 0 {
+		t.Fatalf("expected no caffeine before the coffee but found %f", mg)
+	}
+	if mg := servings.CaffeineAt(morning.Add(5*time.Hour), 5*time.Hour); math.Abs(mg-150) > 1e-9 {
+		t.Fatalf("expected 150 mg after one half-life but found %f", mg)
+	}
+	if mg := servings.CaffeineAt(morning.Add(15*time.Hour), 5*time.Hour); math.Abs(mg-(25+12.5)) > 1e-9 {
+		t.Fatalf("expected 37.5 mg at bedtime but found %f", mg)
+	}
+}
+
+func TestServingRecords_CaffeineCurve(t *testing.T) {
+	morning := time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)
+	servings := gocronometer.ServingRecords{{RecordedTime: morning, HasTime: true, CaffeineMg: 100}}
+
+	curve := servings.CaffeineCurve(morning, morning.Add(12*time.Hour), 6*time.Hour, 6*time.Hour)
+	if len(curve) != 3 {
+		t.Fatalf("expected 3 points but found %+v", curve)
+	}
+	if curve[0].Mg != 100 || math.Abs(curve[1].Mg-50) > 1e-9 || math.Abs(curve[2].Mg-25) > 1e-9 {
+		t.Fatalf("unexpected curve: %+v", curve)
+	}
+	if !curve[2].Time.Equal(morning.Add(12 * time.Hour)) {
+		t.Fatalf("expected the curve to end at %s but found %s", morning.Add(12*time.Hour), curve[2].Time)
+	}
+}
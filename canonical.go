@@ -0,0 +1,157 @@
+package gocronometer
+
+import (
+	"sort"
+	"strings"
+)
+
+// canonicalUnits maps the lower case spellings of units to the spelling used by Cronometer.
+var canonicalUnits = map[string]string{
+	"lb":        "lbs",
+	"lbs":       "lbs",
+	"pound":     "lbs",
+	"pounds":    "lbs",
+	"kg":        "kg",
+	"kgs":       "kg",
+	"kilogram":  "kg",
+	"kilograms": "kg",
+	"g":         "g",
+	"gram":      "g",
+	"grams":     "g",
+	"mg/dl":     "mg/dL",
+	"mmol/l":    "mmol/L",
+	"mmhg":      "mmHg",
+	"bpm":       "bpm",
+}
+
+// Canonicalize normalizes the servings and sorts them deterministically, so two exports of the same data serialize
+// identically. Whitespace in the text fields is collapsed, units are given the spelling used by Cronometer, and the
+// servings are sorted by day, time of day, group, food and then their remaining fields. The deprecated fields are set
+// from the fields replacing them.
+func (s ServingRecords) Canonicalize() {
+	for i := range s {
+		r := &s[i]
+		r.Group = normalizeSpace(r.Group)
+		r.FoodName = normalizeSpace(r.FoodName)
+		r.Source = normalizeSpace(r.Source)
+		r.FoodID = normalizeSpace(r.FoodID)
+		r.Category = normalizeSpace(r.Category)
+		r.QuantityUnits = canonicalUnit(r.QuantityUnits)
+		r.B12Mg, r.VitaminKMg = r.B12Ug, r.VitaminKUg
+	}
+
+	sortCanonical(s, ServingRecord.canonicalFields)
+}
+
+// canonicalFields returns the fields of the serving in the order they are sorted by Canonicalize.
+func (s ServingRecord) canonicalFields() canonicalFields {
+	floats := []float64{s.QuantityValue}
+	for _, n := range nutrients {
+		floats = append(floats, n.Value(s))
+	}
+
+	return canonicalFields{
+		text: []string{s.Day.String(), formatTime(s.RecordedTime, s.HasTime), s.Group, s.FoodName, s.QuantityUnits,
+			s.Source, s.FoodID, s.Category},
+		numbers: floats,
+	}
+}
+
+// Canonicalize normalizes the exercises and sorts them deterministically in the same way as
+// ServingRecords.Canonicalize. The exercises are sorted by day, time of day, exercise and then their remaining fields.
+func (e ExerciseRecords) Canonicalize() {
+	for i := range e {
+		e[i].Exercise = normalizeSpace(e[i].Exercise)
+	}
+
+	sortCanonical(e, ExerciseRecord.canonicalFields)
+}
+
+// canonicalFields returns the fields of the exercise in the order they are sorted by Canonicalize.
+func (e ExerciseRecord) canonicalFields() canonicalFields {
+	return canonicalFields{
+		text:    []string{e.Day.String(), formatTime(e.RecordedTime, e.HasTime), e.Exercise},
+		numbers: []float64{e.Minutes, e.CaloriesBurned},
+	}
+}
+
+// Canonicalize normalizes the biometrics and sorts them deterministically in the same way as
+// ServingRecords.Canonicalize. The biometrics are sorted by day, time of day, metric, unit and then amount.
+func (b BiometricRecords) Canonicalize() {
+	for i := range b {
+		b[i].Metric = normalizeSpace(b[i].Metric)
+		b[i].Unit = canonicalUnit(b[i].Unit)
+	}
+
+	sortCanonical(b, BiometricRecord.canonicalFields)
+}
+
+// canonicalFields returns the fields of the biometric in the order they are sorted by Canonicalize.
+func (b BiometricRecord) canonicalFields() canonicalFields {
+	return canonicalFields{
+		text:    []string{b.Day.String(), formatTime(b.RecordedTime, b.HasTime), b.Metric, b.Unit},
+		numbers: []float64{b.Amount},
+	}
+}
+
+// sortCanonical sorts the records by their canonical fields. The fields of each record are built once before sorting
+// rather than on every comparison.
+func sortCanonical[T any](records []T, fields func(T) canonicalFields) {
+	keyed := make([]canonicalRecord[T], len(records))
+	for i, r := range records {
+		keyed[i] = canonicalRecord[T]{fields: fields(r), record: r}
+	}
+
+	sort.SliceStable(keyed, func(i, j int) bool {
+		return keyed[i].fields.less(keyed[j].fields)
+	})
+
+	for i := range keyed {
+		records[i] = keyed[i].record
+	}
+}
+
+// canonicalRecord is a record with its canonical fields, as sorted by sortCanonical.
+type canonicalRecord[T any] struct {
+	fields canonicalFields
+	record T
+}
+
+// canonicalFields are the fields of a record in the order they are sorted by Canonicalize. Records of the same type
+// always have the same number of fields.
+type canonicalFields struct {
+	text    []string
+	numbers []float64
+}
+
+// less compares the text fields of two records and then their numeric fields.
+func (f canonicalFields) less(o canonicalFields) bool {
+	for i := range f.text {
+		if f.text[i] != o.text[i] {
+			return f.text[i] < o.text[i]
+		}
+	}
+	for i := range f.numbers {
+		if f.numbers[i] != o.numbers[i] {
+			return f.numbers[i] < o.numbers[i]
+		}
+	}
+
+	return false
+}
+
+// normalizeSpace trims the text and collapses runs of whitespace into a single space.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// canonicalUnit normalizes the whitespace of the unit and gives known units the spelling used by Cronometer. Other
+// units are left in the case they were given.
+func canonicalUnit(unit string) string {
+	unit = normalizeSpace(unit)
+	if canonical, ok := canonicalUnits[strings.ToLower(unit)]; ok {
+		return canonical
+	}
+
+	return unit
+}
@@ -0,0 +1,61 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestServingRecords_Canonicalize(t *testing.T) {
+	a, err := gocronometer.ParseServings(strings.NewReader("Day,Time,Group,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-02,08:00,Breakfast,Eggs,2 large,140\n" +
+		"2021-06-01,12:00,Lunch,Rice,1 cup,200\n" +
+		"2021-06-01,12:00,Lunch,Beans,100 g,120\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := gocronometer.ParseServings(strings.NewReader("Day,Time,Group,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,12:00,Lunch, Beans ,100 Grams,120\n" +
+		"2021-06-02,08:00,Breakfast,Eggs,2 large,140\n" +
+		"2021-06-01,12:00,Lunch,Rice,1 cup,200\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.Canonicalize()
+	b.Canonicalize()
+
+	if a[0].FoodName != "Beans" || a[1].FoodName != "Rice" || a[2].FoodName != "Eggs" {
+		t.Fatalf("unexpected order: %+v", a)
+	}
+
+	var aCSV, bCSV bytes.Buffer
+	if err := gocronometer.WriteServingsCSV(&aCSV, a); err != nil {
+		t.Fatal(err)
+	}
+	if err := gocronometer.WriteServingsCSV(&bCSV, b); err != nil {
+		t.Fatal(err)
+	}
+	if aCSV.String() != bCSV.String() {
+		t.Fatalf("expected identical exports but found:\n%s\n%s", aCSV.String(), bCSV.String())
+	}
+}
+
+func TestBiometricRecords_Canonicalize(t *testing.T) {
+	biometrics := gocronometer.BiometricRecords{
+		{Metric: "Weight", Unit: "LB", Amount: 180},
+		{Metric: "Blood  Pressure", Unit: "mmhg", Amount: 120},
+		{Metric: "Weight", Unit: "lbs", Amount: 179},
+	}
+	biometrics.Canonicalize()
+
+	expected := gocronometer.BiometricRecords{
+		{Metric: "Blood Pressure", Unit: "mmHg", Amount: 120},
+		{Metric: "Weight", Unit: "lbs", Amount: 179},
+		{Metric: "Weight", Unit: "lbs", Amount: 180},
+	}
+	if !biometrics.Equal(expected) {
+		t.Fatalf("expected %+v but found %+v", expected, biometrics)
+	}
+}
@@ -0,0 +1,271 @@
+// Package cgm imports continuous glucose monitor exports from LibreView and Dexcom Clarity into the gocronometer
+// biometric records, and aligns the glucose readings with the meals of a servings export to analyze the glucose
+// response to each meal.
+package cgm
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Metric is the metric name given to the glucose readings, matching the Cronometer biometric.
+const Metric = "Blood Glucose"
+
+// The following are the layouts of the timestamps in the exports.
+const (
+	libreViewTimeLayout = "01-02-2006 15:04"
+	dexcomTimeLayout    = "2006-01-02T15:04:05"
+)
+
+// ParseLibreView parses a LibreView glucose export into biometric records. Both the historic readings recorded
+// automatically by the sensor and the readings from scans are included. LibreView writes a title line before the
+// headers, which is skipped. A nil location is treated as UTC.
+func ParseLibreView(r io.Reader, location *time.Location) (gocronometer.BiometricRecords, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading libreview export: %s", err)
+	}
+
+	headerRow := -1
+	for i, row := range rows {
+		if len(row) > 0 && strings.TrimSpace(row[0]) == "Device" {
+			headerRow = i
+			break
+		}
+	}
+	if headerRow < 0 {
+		return nil, fmt.Errorf("libreview export has no header row")
+	}
+
+	var (
+		timestamp = -1
+		values    []int
+		unit      string
+	)
+	for i, h := range rows[headerRow] {
+		h = strings.TrimSpace(h)
+		switch {
+		case h == "Device Timestamp":
+			timestamp = i
+		case strings.HasPrefix(h, "Historic Glucose ") || strings.HasPrefix(h, "Scan Glucose "):
+			values = append(values, i)
+			unit = h[strings.LastIndexByte(h, ' ')+1:]
+		}
+	}
+	if timestamp < 0 || len(values) == 0 {
+		return nil, fmt.Errorf("libreview export is missing the timestamp or glucose columns")
+	}
+
+	var records gocronometer.BiometricRecords
+	for n, row := range rows[headerRow+1:] {
+		for _, i := range values {
+			if i >= len(row) || strings.TrimSpace(row[i]) == "" {
+				continue
+			}
+
+			t, err := time.ParseInLocation(libreViewTimeLayout, strings.TrimSpace(row[timestamp]), location)
+			if err != nil {
+				return nil, fmt.Errorf("parsing libreview row %d timestamp: %s", headerRow+n+2, err)
+			}
+
+			amount, err := strconv.ParseFloat(strings.TrimSpace(row[i]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing libreview row %d glucose: %s", headerRow+n+2, err)
+			}
+
+			records = append(records, reading(t, unit, amount))
+		}
+	}
+
+	records.SortByTime()
+	return records, nil
+}
+
+// ParseDexcom parses a Dexcom Clarity export into biometric records. Only the estimated glucose value (EGV) events
+// are included, and readings reported as "Low" or "High" rather than as a number are skipped. A nil location is
+// treated as UTC.
+func ParseDexcom(r io.Reader, location *time.Location) (gocronometer.BiometricRecords, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading dexcom headers: %s", err)
+	}
+
+	var (
+		timestamp = -1
+		eventType = -1
+		value     = -1
+		unit      string
+	)
+	for i, h := range headers {
+		h = strings.TrimSpace(h)
+		switch {
+		case strings.HasPrefix(h, "Timestamp"):
+			timestamp = i
+		case h == "Event Type":
+			eventType = i
+		case strings.HasPrefix(h, "Glucose Value"):
+			value = i
+			unit = strings.TrimSuffix(strings.TrimPrefix(h, "Glucose Value ("), ")")
+		}
+	}
+	if timestamp < 0 || eventType < 0 || value < 0 {
+		return nil, fmt.Errorf("dexcom export is missing the timestamp, event type or glucose columns")
+	}
+
+	var (
+		records gocronometer.BiometricRecords
+		row     = 1
+	)
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("reading dexcom row %d: %s", row, err)
+		}
+
+		if len(fields) <= value || len(fields) <= timestamp || strings.TrimSpace(fields[eventType]) != "EGV" {
+			continue
+		}
+
+		amount, err := strconv.ParseFloat(strings.TrimSpace(fields[value]), 64)
+		if err != nil {
+			// Readings out of the sensor range are written as Low or High.
+			continue
+		}
+
+		t, err := time.ParseInLocation(dexcomTimeLayout, strings.TrimSpace(fields[timestamp]), location)
+		if err != nil {
+			return nil, fmt.Errorf("parsing dexcom row %d timestamp: %s", row, err)
+		}
+
+		records = append(records, reading(t, unit, amount))
+	}
+
+	records.SortByTime()
+	return records, nil
+}
+
+// reading creates a glucose biometric record.
+func reading(t time.Time, unit string, amount float64) gocronometer.BiometricRecord {
+	return gocronometer.BiometricRecord{
+		RecordedTime: t,
+		Day:          gocronometer.DateOf(t),
+		HasTime:      true,
+		Metric:       Metric,
+		Unit:         unit,
+		Amount:       amount,
+	}
+}
+
+// BaselineWindow is how long before a meal a reading may be taken to be used as the baseline of the meal.
+const BaselineWindow = 15 * time.Minute
+
+// MealResponse is the glucose response to a meal.
+type MealResponse struct {
+	Day      gocronometer.Date
+	Group    string
+	Time     time.Time // Time of the earliest serving of the meal.
+	Servings gocronometer.ServingRecords
+	Readings gocronometer.BiometricRecords // Readings from the meal time to the end of the window.
+	Baseline float64                       // Last reading within BaselineWindow before the meal, or the first reading after it.
+	Peak     float64
+	PeakTime time.Time
+}
+
+// Rise is the difference between the peak and the baseline.
+func (m MealResponse) Rise() float64 {
+	return m.Peak - m.Baseline
+}
+
+// AlignMeals matches the glucose readings to the meals of the servings. The servings of each group on each day form
+// a meal starting at the earliest of their times, and the readings from then until window later are its response.
+// Servings without a time of day cannot be aligned and are ignored, as are meals without readings. Readings of
+// metrics other than Metric are ignored. The responses are sorted by meal time.
+func AlignMeals(servings gocronometer.ServingRecords, glucose gocronometer.BiometricRecords, window time.Duration) []MealResponse {
+	type mealKey struct {
+		day   gocronometer.Date
+		group string
+	}
+
+	var timed gocronometer.ServingRecords
+	for _, s := range servings {
+		if s.HasTime {
+			timed = append(timed, s)
+		}
+	}
+	meals := gocronometer.GroupBy(timed, func(s gocronometer.ServingRecord) mealKey {
+		return mealKey{day: s.Day, group: s.Group}
+	})
+
+	var readings gocronometer.BiometricRecords
+	for _, b := range glucose {
+		if strings.EqualFold(b.Metric, Metric) {
+			readings = append(readings, b)
+		}
+	}
+	readings.SortByTime()
+
+	var responses []MealResponse
+	for key, meal := range meals {
+		start := meal[0].RecordedTime
+		for _, s := range meal[1:] {
+			if s.RecordedTime.Before(start) {
+				start = s.RecordedTime
+			}
+		}
+		end := start.Add(window)
+
+		response := MealResponse{Day: key.day, Group: key.group, Time: start, Servings: meal}
+		hasBaseline := false
+		for _, b := range readings {
+			switch {
+			case b.RecordedTime.Before(start):
+				if !b.RecordedTime.Before(start.Add(-BaselineWindow)) {
+					response.Baseline = b.Amount
+					hasBaseline = true
+				}
+			case !b.RecordedTime.After(end):
+				if len(response.Readings) == 0 && !hasBaseline {
+					response.Baseline = b.Amount
+				}
+				if len(response.Readings) == 0 || b.Amount > response.Peak {
+					response.Peak = b.Amount
+					response.PeakTime = b.RecordedTime
+				}
+				response.Readings = append(response.Readings, b)
+			}
+		}
+
+		if len(response.Readings) > 0 {
+			responses = append(responses, response)
+		}
+	}
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Time.Before(responses[j].Time)
+	})
+	return responses
+}
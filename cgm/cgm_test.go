@@ -0,0 +1,81 @@
+package cgm_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/cgm"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLibreView(t *testing.T) {
+	raw := "Glucose Data,Generated on,06-02-2021 10:00 UTC,Generated by,Jane Doe\n" +
+		"Device,Serial Number,Device Timestamp,Record Type,Historic Glucose mg/dL,Scan Glucose mg/dL,Notes\n" +
+		"FreeStyle LibreLink,ABC,06-01-2021 07:30,0,95,,\n" +
+		"FreeStyle LibreLink,ABC,06-01-2021 07:20,1,,92,\n" +
+		"FreeStyle LibreLink,ABC,06-01-2021 07:40,6,,,Breakfast\n"
+
+	records, err := cgm.ParseLibreView(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 readings but found %+v", records)
+	}
+
+	if records[0].Amount != 92 || records[0].Unit != "mg/dL" || records[0].Metric != cgm.Metric {
+		t.Fatalf("unexpected reading: %+v", records[0])
+	}
+}
+
+func TestParseDexcom(t *testing.T) {
+	raw := "Index,Timestamp (YYYY-MM-DDThh:mm:ss),Event Type,Event Subtype,Patient Info,Glucose Value (mmol/L)\n" +
+		"1,,FirstName,,Jane,\n" +
+		"2,2021-06-01T07:30:00,EGV,,,5.2\n" +
+		"3,2021-06-01T07:35:00,EGV,,,Low\n" +
+		"4,2021-06-01T07:40:00,Carbs,,,\n"
+
+	records, err := cgm.ParseDexcom(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 1 || records[0].Amount != 5.2 || records[0].Unit != "mmol/L" {
+		t.Fatalf("unexpected readings: %+v", records)
+	}
+}
+
+func TestAlignMeals(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2021, 6, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	servings := gocronometer.ServingRecords{
+		{Day: day, HasTime: true, RecordedTime: at(8, 5), Group: "Breakfast", FoodName: "Toast"},
+		{Day: day, HasTime: true, RecordedTime: at(8, 0), Group: "Breakfast", FoodName: "Eggs"},
+		{Day: day, Group: "Lunch", FoodName: "Soup"},
+	}
+	glucose := gocronometer.BiometricRecords{
+		{Metric: cgm.Metric, RecordedTime: at(7, 30), Amount: 80},
+		{Metric: cgm.Metric, RecordedTime: at(7, 55), Amount: 90},
+		{Metric: cgm.Metric, RecordedTime: at(8, 30), Amount: 140},
+		{Metric: cgm.Metric, RecordedTime: at(9, 0), Amount: 120},
+		{Metric: cgm.Metric, RecordedTime: at(11, 0), Amount: 85},
+	}
+
+	responses := cgm.AlignMeals(servings, glucose, 2*time.Hour)
+	if len(responses) != 1 {
+		t.Fatalf("expected only breakfast to be aligned but found %+v", responses)
+	}
+
+	r := responses[0]
+	if !r.Time.Equal(at(8, 0)) || len(r.Servings) != 2 || len(r.Readings) != 2 {
+		t.Fatalf("unexpected response: %+v", r)
+	}
+
+	if r.Baseline != 90 || r.Peak != 140 || r.Rise() != 50 || !r.PeakTime.Equal(at(8, 30)) {
+		t.Fatalf("unexpected response: %+v", r)
+	}
+}
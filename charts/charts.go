@@ -0,0 +1,156 @@
+// Package charts builds trend charts from parsed Cronometer records and renders them as SVG or PNG images, so reports
+// and command line tools can include visuals without external tooling.
+package charts
+
+import (
+	"github.com/burke/gocronometer"
+	"image/color"
+	"sort"
+	"strings"
+)
+
+// Kind is the kind of a chart.
+type Kind int
+
+// The following are the kinds of charts.
+const (
+	// Line charts plot each series as a line across the labels.
+	Line Kind = iota
+
+	// Bar charts plot the series as groups of bars for each label, starting at zero.
+	Bar
+
+	// Pie charts plot the values of the first series as slices named by the labels.
+	Pie
+)
+
+// Series is a named set of values, one for each label of the chart.
+type Series struct {
+	Name   string
+	Color  color.RGBA
+	Values []float64
+}
+
+// Chart is a chart ready to be rendered.
+type Chart struct {
+	Title  string
+	Kind   Kind
+	Labels []string
+	Series []Series
+	Width  int
+	Height int
+}
+
+// The following are the default dimensions of charts, in pixels.
+const (
+	DefaultWidth  = 640
+	DefaultHeight = 400
+)
+
+// Palette is the colors given to series and pie slices, in order.
+var Palette = []color.RGBA{
+	{R: 0x44, G: 0x77, B: 0xaa, A: 0xff},
+	{R: 0xee, G: 0x66, B: 0x77, A: 0xff},
+	{R: 0x22, G: 0x88, B: 0x33, A: 0xff},
+	{R: 0xcc, G: 0xbb, B: 0x44, A: 0xff},
+	{R: 0x66, G: 0xcc, B: 0xee, A: 0xff},
+	{R: 0xaa, G: 0x33, B: 0x77, A: 0xff},
+}
+
+// WeightTrend charts the daily amount of the weight metric as a line, using the last recording of each day. Only
+// recordings in the unit of the first recording are included.
+func WeightTrend(biometrics gocronometer.BiometricRecords) Chart {
+	return MetricTrend(biometrics, "Weight")
+}
+
+// MetricTrend charts the daily amount of the metric as a line, using the last recording of each day. Metrics are
+// matched without regard to case and only recordings in the unit of the first recording are included.
+func MetricTrend(biometrics gocronometer.BiometricRecords, metric string) Chart {
+	var records gocronometer.BiometricRecords
+	for _, b := range biometrics {
+		if strings.EqualFold(b.Metric, metric) {
+			records = append(records, b)
+		}
+	}
+	records.SortByTime()
+
+	chart := newChart(metric, Line)
+	series := Series{Name: metric, Color: Palette[0]}
+	if len(records) > 0 {
+		series.Name = metric + " (" + records[0].Unit + ")"
+		chart.Title = series.Name
+	}
+
+	for _, b := range records {
+		if b.Unit != records[0].Unit {
+			continue
+		}
+		label := b.Day.String()
+		if n := len(chart.Labels); n > 0 && chart.Labels[n-1] == label {
+			series.Values[n-1] = b.Amount
+			continue
+		}
+		chart.Labels = append(chart.Labels, label)
+		series.Values = append(series.Values, b.Amount)
+	}
+
+	chart.Series = []Series{series}
+	return chart
+}
+
+// IntakeVsBurn charts the energy eaten and the energy burned by exercise on each day as bars.
+func IntakeVsBurn(servings gocronometer.ServingRecords, exercises gocronometer.ExerciseRecords) Chart {
+	intake := map[gocronometer.Date]float64{}
+	for _, s := range servings {
+		intake[s.Day] += s.EnergyKcal
+	}
+	burn := map[gocronometer.Date]float64{}
+	for _, e := range exercises {
+		burn[e.Day] += e.CaloriesBurned
+	}
+
+	days := map[gocronometer.Date]bool{}
+	for d := range intake {
+		days[d] = true
+	}
+	for d := range burn {
+		days[d] = true
+	}
+	ordered := make([]gocronometer.Date, 0, len(days))
+	for d := range days {
+		ordered = append(ordered, d)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Before(ordered[j])
+	})
+
+	chart := newChart("Energy (kcal)", Bar)
+	eaten := Series{Name: "Intake", Color: Palette[0]}
+	burned := Series{Name: "Burned", Color: Palette[1]}
+	for _, d := range ordered {
+		chart.Labels = append(chart.Labels, d.String())
+		eaten.Values = append(eaten.Values, intake[d])
+		burned.Values = append(burned.Values, burn[d])
+	}
+
+	chart.Series = []Series{eaten, burned}
+	return chart
+}
+
+// MacroDistribution charts the share of the energy from each macro as a pie.
+func MacroDistribution(servings gocronometer.ServingRecords) Chart {
+	m := servings.MacroEnergy()
+
+	chart := newChart("Macro Distribution", Pie)
+	chart.Labels = []string{"Protein", "Carbs", "Fat", "Alcohol"}
+	chart.Series = []Series{{
+		Name:   "Energy (kcal)",
+		Values: []float64{m.ProteinKcal, m.CarbsKcal, m.FatKcal, m.AlcoholKcal},
+	}}
+	return chart
+}
+
+// newChart creates a chart with the default dimensions.
+func newChart(title string, kind Kind) Chart {
+	return Chart{Title: title, Kind: kind, Width: DefaultWidth, Height: DefaultHeight}
+}
@@ -0,0 +1,49 @@
+package charts_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/charts"
+	"testing"
+	"time"
+)
+
+func date(day int) gocronometer.Date {
+	return gocronometer.Date{Year: 2021, Month: time.June, Day: day}
+}
+
+func TestWeightTrend(t *testing.T) {
+	biometrics := gocronometer.BiometricRecords{
+		{Day: date(2), RecordedTime: time.Date(2021, 6, 2, 8, 0, 0, 0, time.UTC), Metric: "Weight", Unit: "kg", Amount: 80},
+		{Day: date(1), RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), Metric: "Weight", Unit: "kg", Amount: 81},
+		{Day: date(2), RecordedTime: time.Date(2021, 6, 2, 20, 0, 0, 0, time.UTC), Metric: "Weight", Unit: "kg", Amount: 80.5},
+		{Day: date(3), RecordedTime: time.Date(2021, 6, 3, 8, 0, 0, 0, time.UTC), Metric: "Weight", Unit: "lbs", Amount: 178},
+		{Day: date(3), RecordedTime: time.Date(2021, 6, 3, 8, 0, 0, 0, time.UTC), Metric: "Heart Rate", Unit: "bpm", Amount: 60},
+	}
+
+	chart := charts.WeightTrend(biometrics)
+	if chart.Kind != charts.Line || chart.Title != "Weight (kg)" {
+		t.Fatalf("unexpected chart: %+v", chart)
+	}
+
+	values := chart.Series[0].Values
+	if len(chart.Labels) != 2 || chart.Labels[0] != "2021-06-01" || values[0] != 81 || values[1] != 80.5 {
+		t.Fatalf("unexpected trend: %v %v", chart.Labels, values)
+	}
+}
+
+func TestIntakeVsBurn(t *testing.T) {
+	servings := gocronometer.ServingRecords{{Day: date(1), EnergyKcal: 2000}, {Day: date(1), EnergyKcal: 100}}
+	exercises := gocronometer.ExerciseRecords{{Day: date(2), CaloriesBurned: 300}}
+
+	chart := charts.IntakeVsBurn(servings, exercises)
+	if len(chart.Labels) != 2 || chart.Series[0].Values[0] != 2100 || chart.Series[1].Values[1] != 300 {
+		t.Fatalf("unexpected chart: %+v", chart)
+	}
+}
+
+func TestMacroDistribution(t *testing.T) {
+	chart := charts.MacroDistribution(gocronometer.ServingRecords{{ProteinG: 10, FatG: 10}})
+	if chart.Kind != charts.Pie || chart.Series[0].Values[0] != 40 || chart.Series[0].Values[2] != 90 {
+		t.Fatalf("unexpected chart: %+v", chart)
+	}
+}
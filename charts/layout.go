@@ -0,0 +1,248 @@
+package charts
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+)
+
+// shapeKind is the kind of a shape drawn by a chart.
+type shapeKind int
+
+const (
+	polylineShape shapeKind = iota
+	rectShape
+	wedgeShape
+	textShape
+)
+
+// point is a position in pixels from the top left of the chart.
+type point struct {
+	x, y float64
+}
+
+// shape is a primitive drawn by a chart. Both renderers draw the same shapes, so the SVG and PNG images of a chart
+// only differ in that the PNG images have no text.
+type shape struct {
+	kind   shapeKind
+	color  color.RGBA
+	points []point // Vertices of a polyline.
+
+	// Bounds of a rectangle, or the position of text.
+	x, y, w, h float64
+
+	// Center, radius and angles of a wedge. Angles are in radians clockwise from the top.
+	cx, cy, r, start, end float64
+
+	text   string
+	anchor string // SVG text-anchor of the text.
+}
+
+// The following are the margins around the plot area, in pixels.
+const (
+	marginLeft   = 60
+	marginRight  = 20
+	marginTop    = 50
+	marginBottom = 50
+)
+
+// The following are the colors of the chart furniture.
+var (
+	axisColor = color.RGBA{R: 0x66, G: 0x66, B: 0x66, A: 0xff}
+	gridColor = color.RGBA{R: 0xdd, G: 0xdd, B: 0xdd, A: 0xff}
+	textColor = color.RGBA{R: 0x22, G: 0x22, B: 0x22, A: 0xff}
+)
+
+// The following limit the number of labels drawn on the axes.
+const (
+	yTicks     = 5
+	maxXLabels = 8
+)
+
+// dimensions returns the size of the chart, using the defaults for sizes that are not set.
+func (c Chart) dimensions() (width, height float64) {
+	width, height = float64(c.Width), float64(c.Height)
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	if height <= 0 {
+		height = DefaultHeight
+	}
+	return width, height
+}
+
+// layout lays out the shapes of the chart.
+func (c Chart) layout() []shape {
+	width, _ := c.dimensions()
+
+	shapes := []shape{{kind: textShape, color: textColor, x: width / 2, y: marginTop / 2, text: c.Title, anchor: "middle"}}
+	if c.Kind == Pie {
+		return append(shapes, c.layoutPie()...)
+	}
+	return append(shapes, c.layoutAxes()...)
+}
+
+// layoutPie lays out a pie of the first series with a legend of the slices.
+func (c Chart) layoutPie() []shape {
+	if len(c.Series) == 0 {
+		return nil
+	}
+	width, height := c.dimensions()
+	values := c.Series[0].Values
+
+	total := 0.0
+	for _, v := range values {
+		total += math.Max(v, 0)
+	}
+
+	plotHeight := height - marginTop - marginBottom
+	r := plotHeight / 2
+	cx, cy := marginLeft+r, marginTop+r
+
+	var shapes []shape
+	angle := 0.0
+	for i, v := range values {
+		col := Palette[i%len(Palette)]
+		share := 0.0
+		if total > 0 {
+			share = math.Max(v, 0) / total
+		}
+		if share > 0 {
+			shapes = append(shapes, shape{kind: wedgeShape, color: col, cx: cx, cy: cy, r: r, start: angle,
+				end: angle + share*2*math.Pi})
+			angle += share * 2 * math.Pi
+		}
+
+		label := ""
+		if i < len(c.Labels) {
+			label = c.Labels[i]
+		}
+		y := marginTop + float64(i)*20
+		legendX := math.Min(cx+r+30, width-150)
+		shapes = append(shapes,
+			shape{kind: rectShape, color: col, x: legendX, y: y, w: 12, h: 12},
+			shape{kind: textShape, color: textColor, x: legendX + 18, y: y + 11,
+				text: fmt.Sprintf("%s %.0f%%", label, share*100), anchor: "start"},
+		)
+	}
+	return shapes
+}
+
+// layoutAxes lays out a line or bar chart with its axes, grid and legend.
+func (c Chart) layoutAxes() []shape {
+	width, height := c.dimensions()
+	left, top := float64(marginLeft), float64(marginTop)
+	plotWidth, plotHeight := width-marginLeft-marginRight, height-marginTop-marginBottom
+	bottom := top + plotHeight
+
+	low, high := c.valueRange()
+	y := func(v float64) float64 {
+		return bottom - (v-low)/(high-low)*plotHeight
+	}
+
+	var shapes []shape
+	for i := 0; i <= yTicks; i++ {
+		v := low + (high-low)*float64(i)/yTicks
+		shapes = append(shapes,
+			shape{kind: polylineShape, color: gridColor, points: []point{{left, y(v)}, {left + plotWidth, y(v)}}},
+			shape{kind: textShape, color: textColor, x: left - 6, y: y(v) + 4, text: formatTick(v), anchor: "end"},
+		)
+	}
+	shapes = append(shapes, shape{kind: polylineShape, color: axisColor,
+		points: []point{{left, top}, {left, bottom}, {left + plotWidth, bottom}}})
+
+	n := len(c.Labels)
+	step := 1
+	if n > maxXLabels {
+		step = (n + maxXLabels - 1) / maxXLabels
+	}
+
+	// Lines place the labels on the edges of the plot while bars place them in the middle of their group.
+	x := func(i int) float64 {
+		if c.Kind == Bar {
+			return left + (float64(i)+0.5)*plotWidth/float64(n)
+		}
+		if n == 1 {
+			return left + plotWidth/2
+		}
+		return left + float64(i)*plotWidth/float64(n-1)
+	}
+	for i := 0; i < n; i += step {
+		shapes = append(shapes, shape{kind: textShape, color: textColor, x: x(i), y: bottom + 20, text: c.Labels[i],
+			anchor: "middle"})
+	}
+
+	for s, series := range c.Series {
+		col := series.Color
+		if col.A == 0 {
+			col = Palette[s%len(Palette)]
+		}
+
+		switch c.Kind {
+		case Bar:
+			groupWidth := plotWidth / float64(n)
+			barWidth := groupWidth * 0.8 / float64(len(c.Series))
+			for i, v := range series.Values {
+				if i >= n {
+					break
+				}
+				barX := x(i) - groupWidth*0.4 + float64(s)*barWidth
+				shapes = append(shapes, shape{kind: rectShape, color: col, x: barX, y: y(v), w: barWidth,
+					h: bottom - y(v)})
+			}
+		default:
+			var points []point
+			for i, v := range series.Values {
+				if i >= n {
+					break
+				}
+				points = append(points, point{x(i), y(v)})
+				shapes = append(shapes, shape{kind: rectShape, color: col, x: x(i) - 2, y: y(v) - 2, w: 4, h: 4})
+			}
+			if len(points) > 1 {
+				shapes = append(shapes, shape{kind: polylineShape, color: col, points: points})
+			}
+		}
+
+		if len(c.Series) > 1 {
+			legendX := left + plotWidth - 100
+			legendY := top - 20 + float64(s)*16
+			shapes = append(shapes,
+				shape{kind: rectShape, color: col, x: legendX, y: legendY - 10, w: 12, h: 12},
+				shape{kind: textShape, color: textColor, x: legendX + 18, y: legendY, text: series.Name, anchor: "start"},
+			)
+		}
+	}
+
+	return shapes
+}
+
+// valueRange is the range of the y axis. Bars start at zero while lines are fitted to their values.
+func (c Chart) valueRange() (low, high float64) {
+	low, high = math.Inf(1), math.Inf(-1)
+	for _, series := range c.Series {
+		for _, v := range series.Values {
+			low, high = math.Min(low, v), math.Max(high, v)
+		}
+	}
+
+	if math.IsInf(low, 1) {
+		return 0, 1
+	}
+	if c.Kind == Bar {
+		low = math.Min(low, 0)
+	} else {
+		padding := (high - low) * 0.05
+		low, high = low-padding, high+padding
+	}
+	if high == low {
+		low, high = low-1, high+1
+	}
+	return low, high
+}
+
+// formatTick formats a value of the y axis.
+func formatTick(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
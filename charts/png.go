@@ -0,0 +1,76 @@
+package charts
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+)
+
+// WritePNG renders the chart to w as a PNG image. The standard library has no fonts, so unlike WriteSVG the image
+// has no title, labels or legend text.
+func WritePNG(w io.Writer, c Chart) error {
+	width, height := c.dimensions()
+	img := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+
+	for _, s := range c.layout() {
+		switch s.kind {
+		case polylineShape:
+			for i := 1; i < len(s.points); i++ {
+				drawLine(img, s.points[i-1], s.points[i], s.color)
+			}
+		case rectShape:
+			r := image.Rect(int(math.Round(s.x)), int(math.Round(s.y)), int(math.Round(s.x+s.w)), int(math.Round(s.y+s.h)))
+			draw.Draw(img, r, image.NewUniform(s.color), image.Point{}, draw.Src)
+		case wedgeShape:
+			drawWedge(img, s)
+		}
+	}
+
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("writing png chart: %s", err)
+	}
+	return nil
+}
+
+// drawLine draws a line two pixels wide between the points.
+func drawLine(img *image.RGBA, from point, to point, c color.RGBA) {
+	steps := int(math.Max(math.Abs(to.x-from.x), math.Abs(to.y-from.y)))
+	if steps == 0 {
+		steps = 1
+	}
+
+	for i := 0; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		x := int(math.Round(from.x + (to.x-from.x)*t))
+		y := int(math.Round(from.y + (to.y-from.y)*t))
+		img.SetRGBA(x, y, c)
+		img.SetRGBA(x+1, y, c)
+		img.SetRGBA(x, y+1, c)
+	}
+}
+
+// drawWedge fills the pixels of the wedge.
+func drawWedge(img *image.RGBA, s shape) {
+	for y := int(s.cy - s.r); y <= int(s.cy+s.r); y++ {
+		for x := int(s.cx - s.r); x <= int(s.cx+s.r); x++ {
+			dx, dy := float64(x)-s.cx, float64(y)-s.cy
+			if dx*dx+dy*dy > s.r*s.r {
+				continue
+			}
+
+			// Angles are clockwise from the top, matching the layout.
+			angle := math.Atan2(dx, -dy)
+			if angle < 0 {
+				angle += 2 * math.Pi
+			}
+			if angle >= s.start && angle < s.end {
+				img.SetRGBA(x, y, s.color)
+			}
+		}
+	}
+}
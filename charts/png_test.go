@@ -0,0 +1,35 @@
+package charts_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/charts"
+	"image/png"
+	"testing"
+)
+
+func TestWritePNG(t *testing.T) {
+	chart := charts.MacroDistribution(gocronometer.ServingRecords{{ProteinG: 10}})
+	chart.Width, chart.Height = 200, 150
+
+	var buf bytes.Buffer
+	if err := charts.WritePNG(&buf, chart); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if img.Bounds().Dx() != 200 || img.Bounds().Dy() != 150 {
+		t.Fatalf("unexpected bounds: %v", img.Bounds())
+	}
+
+	// Protein is the only macro so the center of the pie is its color.
+	r, g, b, _ := img.At(85, 75).RGBA()
+	expected := charts.Palette[0]
+	if uint8(r>>8) != expected.R || uint8(g>>8) != expected.G || uint8(b>>8) != expected.B {
+		t.Fatalf("expected the pie to be drawn in %v", expected)
+	}
+}
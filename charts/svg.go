@@ -0,0 +1,65 @@
+package charts
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+	"math"
+)
+
+// WriteSVG renders the chart to w as an SVG image.
+func WriteSVG(w io.Writer, c Chart) error {
+	bw := bufio.NewWriter(w)
+	width, height := c.dimensions()
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="#ffffff"/>`+"\n")
+
+	for _, s := range c.layout() {
+		switch s.kind {
+		case polylineShape:
+			fmt.Fprintf(bw, `<polyline fill="none" stroke="%s" stroke-width="2" points="`, hex(s.color))
+			for i, p := range s.points {
+				if i > 0 {
+					fmt.Fprint(bw, " ")
+				}
+				fmt.Fprintf(bw, "%.1f,%.1f", p.x, p.y)
+			}
+			fmt.Fprintln(bw, `"/>`)
+		case rectShape:
+			fmt.Fprintf(bw, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`+"\n",
+				s.x, s.y, s.w, s.h, hex(s.color))
+		case wedgeShape:
+			if s.end-s.start >= 2*math.Pi-1e-9 {
+				fmt.Fprintf(bw, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="%s"/>`+"\n", s.cx, s.cy, s.r, hex(s.color))
+				continue
+			}
+			x1, y1 := s.cx+s.r*math.Sin(s.start), s.cy-s.r*math.Cos(s.start)
+			x2, y2 := s.cx+s.r*math.Sin(s.end), s.cy-s.r*math.Cos(s.end)
+			large := 0
+			if s.end-s.start > math.Pi {
+				large = 1
+			}
+			fmt.Fprintf(bw, `<path d="M%.1f,%.1f L%.1f,%.1f A%.1f,%.1f 0 %d 1 %.1f,%.1f Z" fill="%s"/>`+"\n",
+				s.cx, s.cy, x1, y1, s.r, s.r, large, x2, y2, hex(s.color))
+		case textShape:
+			fmt.Fprintf(bw, `<text x="%.1f" y="%.1f" text-anchor="%s" fill="%s">%s</text>`+"\n",
+				s.x, s.y, s.anchor, hex(s.color), html.EscapeString(s.text))
+		}
+	}
+
+	fmt.Fprintln(bw, "</svg>")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing svg chart: %s", err)
+	}
+	return nil
+}
+
+// hex formats the color as an SVG hex color.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
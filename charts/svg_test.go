@@ -0,0 +1,42 @@
+package charts_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/charts"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteSVG(t *testing.T) {
+	servings := gocronometer.ServingRecords{{Day: date(1), EnergyKcal: 2000, ProteinG: 100, CarbsG: 200, FatG: 60}}
+
+	for _, chart := range []charts.Chart{
+		charts.IntakeVsBurn(servings, nil),
+		charts.MacroDistribution(servings),
+		charts.WeightTrend(nil),
+	} {
+		var buf bytes.Buffer
+		if err := charts.WriteSVG(&buf, chart); err != nil {
+			t.Fatal(err)
+		}
+
+		// The image must be well formed XML.
+		decoder := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("invalid svg: %s\n%s", err, buf.String())
+			}
+		}
+
+		if !strings.Contains(buf.String(), ">"+chart.Title+"</text>") {
+			t.Fatalf("expected the title %s in:\n%s", chart.Title, buf.String())
+		}
+	}
+}
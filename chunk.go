@@ -0,0 +1,70 @@
+package gocronometer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// monthChunks splits the range from start to end, inclusive, into the parts within each calendar month, returned as
+// the first and last day of each part.
+func monthChunks(start Date, end Date) [][2]Date {
+	chunks := make([][2]Date, 0)
+	for d := start; !d.After(end); {
+		last := Date{Year: d.Year, Month: d.Month + 1, Day: 1}.AddDays(-1)
+		if last.After(end) {
+			last = end
+		}
+		chunks = append(chunks, [2]Date{d, last})
+		d = last.AddDays(1)
+	}
+
+	return chunks
+}
+
+// stitchExports combines the raw CSV exports of consecutive ranges into a single export with the header of the
+// first. Rows found in more than one export are only kept once, while rows repeated within an export, such as the same
+// food logged twice in a day, are kept as many times as they appear in it. Empty exports are skipped and every other
+// export must have the same header.
+func stitchExports(exports []string) (string, error) {
+	var header []string
+	groups := make([][][]string, 0, len(exports))
+	for i, export := range exports {
+		if strings.TrimSpace(export) == "" {
+			continue
+		}
+
+		r := csv.NewReader(strings.NewReader(export))
+		r.FieldsPerRecord = -1
+		records, err := r.ReadAll()
+		if err != nil {
+			return "", fmt.Errorf("reading export %d: %w", i, err)
+		}
+
+		if header == nil {
+			header = records[0]
+		} else if strings.Join(header, "\x1f") != strings.Join(records[0], "\x1f") {
+			return "", fmt.Errorf("export %d has the header %q instead of %q", i, records[0], header)
+		}
+		groups = append(groups, records[1:])
+	}
+
+	if header == nil {
+		return "", nil
+	}
+
+	rows := mergeRecords(groups, func(row []string) string {
+		return strings.Join(row, "\x1f")
+	})
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,72 @@
+package gocronometer_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc is an http.RoundTripper answering requests with a function.
+type roundTripFunc func(req *http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// fakeCronometer returns a client answering the token and export requests with the exports function, and the ranges
+// exported.
+func fakeCronometer(opts *gocronometer.ClientOptions, exports func(start string, end string) string) (*gocronometer.Client, *[]string) {
+	var ranges []string
+	client := gocronometer.NewClient(opts)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) *http.Response {
+		body := `//OK[1,["token"],0,7]`
+		if req.Method == http.MethodGet {
+			start, end := req.URL.Query().Get("start"), req.URL.Query().Get("end")
+			ranges = append(ranges, start+"/"+end)
+			body = exports(start, end)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}, Request: req}
+	})
+	return client, &ranges
+}
+
+func TestClient_ExportChunking(t *testing.T) {
+	exports := func(start string, end string) string {
+		switch start {
+		case "2021-05-15":
+			return "Day,Time,Food Name\n2021-05-20,08:00,Eggs\n2021-05-20,08:00,Eggs\n2021-06-01,08:00,\"Toast, buttered\"\n"
+		case "2021-06-01":
+			return "Day,Time,Food Name\n2021-06-01,08:00,\"Toast, buttered\"\n2021-06-10,12:00,Soup\n"
+		default:
+			return ""
+		}
+	}
+
+	client, ranges := fakeCronometer(nil, exports)
+	start := time.Date(2021, time.May, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
+	raw, err := client.ExportServings(context.Background(), start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(*ranges, " ") != "2021-05-15/2021-05-31 2021-06-01/2021-06-30 2021-07-01/2021-07-03" {
+		t.Fatalf("unexpected ranges requested: %v", *ranges)
+	}
+	expected := "Day,Time,Food Name\n2021-05-20,08:00,Eggs\n2021-05-20,08:00,Eggs\n2021-06-01,08:00,\"Toast, buttered\"\n2021-06-10,12:00,Soup\n"
+	if raw != expected {
+		t.Fatalf("unexpected stitched export:\n%s", raw)
+	}
+
+	client, ranges = fakeCronometer(&gocronometer.ClientOptions{NoExportChunking: true}, exports)
+	if _, err := client.ExportServings(context.Background(), start, end); err != nil {
+		t.Fatal(err)
+	}
+	if len(*ranges) != 1 {
+		t.Fatalf("expected a single request without chunking but found %v", *ranges)
+	}
+}
@@ -0,0 +1,109 @@
+package gocronometer
+
+// DefaultIncompleteDayKcal is the energy in kcal under which LoggingCompleteness considers a logged day incomplete
+// when no floor is given. Few days are truly eaten under it, so it usually means meals were not logged.
+const DefaultIncompleteDayKcal = 800
+
+// CompletenessOptions configures LoggingCompleteness. The zero value uses the defaults.
+type CompletenessOptions struct {
+	// IncompleteDayKcal is the energy in kcal under which a logged day is incomplete. DefaultIncompleteDayKcal is
+	// used when zero.
+	IncompleteDayKcal float64
+}
+
+// Streak is a run of consecutive logged days.
+type Streak struct {
+	Start Date
+	End   Date
+}
+
+// Days returns the number of days of the streak.
+func (s Streak) Days() int {
+	return daysBetween(s.Start, s.End) + 1
+}
+
+// DayEnergy is the energy logged on a day.
+type DayEnergy struct {
+	Day        Date
+	EnergyKcal float64
+}
+
+// Completeness describes how completely the servings of a range of days were logged.
+type Completeness struct {
+	Start Date
+	End   Date
+
+	// Streaks are the runs of consecutive days with servings, earliest first.
+	Streaks []Streak
+
+	// Missing are the days without servings, earliest first.
+	Missing []Date
+
+	// Incomplete are the days with servings whose energy is under the floor of the options, earliest first.
+	Incomplete []DayEnergy
+}
+
+// LongestStreak returns the longest streak, the earliest of them on ties. False is returned without streaks.
+func (c Completeness) LongestStreak() (Streak, bool) {
+	if len(c.Streaks) == 0 {
+		return Streak{}, false
+	}
+
+	longest := c.Streaks[0]
+	for _, s := range c.Streaks[1:] {
+		if s.Days() > longest.Days() {
+			longest = s
+		}
+	}
+
+	return longest, true
+}
+
+// Ratio returns the fraction of the days of the range with servings, from 0 to 1.
+func (c Completeness) Ratio() float64 {
+	days := daysBetween(c.Start, c.End) + 1
+	if days <= 0 {
+		return 0
+	}
+
+	return float64(days-len(c.Missing)) / float64(days)
+}
+
+// LoggingCompleteness reports the logging streaks, missing days and incomplete days of the servings from start to
+// end, inclusive, to judge the quality of the data before analyzing it. Servings outside the range are ignored.
+func LoggingCompleteness(start Date, end Date, servings ServingRecords, opts CompletenessOptions) Completeness {
+	if opts.IncompleteDayKcal == 0 {
+		opts.IncompleteDayKcal = DefaultIncompleteDayKcal
+	}
+
+	energy := make(map[Date]float64)
+	for _, s := range servings {
+		if s.Day.Before(start) || s.Day.After(end) {
+			continue
+		}
+		energy[s.Day] += s.EnergyKcal
+	}
+
+	c := Completeness{Start: start, End: end, Streaks: make([]Streak, 0), Missing: make([]Date, 0), Incomplete: make([]DayEnergy, 0)}
+	streak := false
+	for d := start; !d.After(end); d = d.AddDays(1) {
+		kcal, ok := energy[d]
+		if !ok {
+			c.Missing = append(c.Missing, d)
+			streak = false
+			continue
+		}
+
+		if kcal < opts.IncompleteDayKcal {
+			c.Incomplete = append(c.Incomplete, DayEnergy{Day: d, EnergyKcal: kcal})
+		}
+		if streak {
+			c.Streaks[len(c.Streaks)-1].End = d
+		} else {
+			c.Streaks = append(c.Streaks, Streak{Start: d, End: d})
+			streak = true
+		}
+	}
+
+	return c
+}
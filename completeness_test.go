@@ -0,0 +1,47 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestLoggingCompleteness(t *testing.T) {
+	start := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: start.AddDays(-1), EnergyKcal: 2000},
+		{Day: start, EnergyKcal: 1200},
+		{Day: start, EnergyKcal: 800},
+		{Day: start.AddDays(1), EnergyKcal: 300},
+		{Day: start.AddDays(3), EnergyKcal: 1900},
+		{Day: start.AddDays(4), EnergyKcal: 2100},
+		{Day: start.AddDays(5), EnergyKcal: 2200},
+	}
+
+	c := gocronometer.LoggingCompleteness(start, start.AddDays(6), servings, gocronometer.CompletenessOptions{})
+	if len(c.Streaks) != 2 || c.Streaks[0].Days() != 2 || c.Streaks[1].Start != start.AddDays(3) || c.Streaks[1].Days() != 3 {
+		t.Fatalf("unexpected streaks: %+v", c.Streaks)
+	}
+	if longest, ok := c.LongestStreak(); !ok || longest != c.Streaks[1] {
+		t.Fatalf("unexpected longest streak: %+v", longest)
+	}
+	if len(c.Missing) != 2 || c.Missing[0] != start.AddDays(2) || c.Missing[1] != start.AddDays(6) {
+		t.Fatalf("unexpected missing days: %+v", c.Missing)
+	}
+	if len(c.Incomplete) != 1 || c.Incomplete[0].Day != start.AddDays(1) || c.Incomplete[0].EnergyKcal != 300 {
+		t.Fatalf("unexpected incomplete days: %+v", c.Incomplete)
+	}
+	if ratio := c.Ratio(); ratio != 5.0/7.0 {
+		t.Fatalf("expected a ratio of 5/7 but found %f", ratio)
+	}
+
+	c = gocronometer.LoggingCompleteness(start, start.AddDays(6), servings, gocronometer.CompletenessOptions{IncompleteDayKcal: 2050})
+	if len(c.Incomplete) != 3 {
+		t.Fatalf("expected 3 incomplete days with a higher floor but found %+v", c.Incomplete)
+	}
+
+	c = gocronometer.LoggingCompleteness(start, start.AddDays(1), nil, gocronometer.CompletenessOptions{})
+	if _, ok := c.LongestStreak(); ok || len(c.Missing) != 2 || c.Ratio() != 0 {
+		t.Fatalf("unexpected completeness without servings: %+v", c)
+	}
+}
@@ -0,0 +1,90 @@
+// Package conversions provides conversion factors between the units used for vitamins. Cronometer exports some
+// vitamins in international units (IU) while dietary reference intakes are expressed in mass, so the conversions are
+// required before comparing intake with targets.
+//
+// The factors are those published by the NIH Office of Dietary Supplements. IU are defined per compound, so the
+// conversions for vitamin A and vitamin E depend on the form of the vitamin.
+package conversions
+
+// VitaminDUgPerIU is the µg of vitamin D (cholecalciferol or ergocalciferol) in one IU.
+const VitaminDUgPerIU = 0.025
+
+// VitaminDIUToUg converts vitamin D from IU to µg.
+func VitaminDIUToUg(iu float64) float64 {
+	return iu * VitaminDUgPerIU
+}
+
+// VitaminDUgToIU converts vitamin D from µg to IU.
+func VitaminDUgToIU(ug float64) float64 {
+	return ug / VitaminDUgPerIU
+}
+
+// VitaminAForm is the form of vitamin A an IU value refers to.
+type VitaminAForm int
+
+const (
+	// Retinol is preformed vitamin A, found in animal foods and most supplements.
+	Retinol VitaminAForm = iota
+
+	// SupplementalBetaCarotene is beta-carotene taken as a supplement.
+	SupplementalBetaCarotene
+
+	// DietaryBetaCarotene is beta-carotene found in food.
+	DietaryBetaCarotene
+
+	// DietaryAlphaCarotene is alpha-carotene or beta-cryptoxanthin found in food.
+	DietaryAlphaCarotene
+)
+
+// VitaminAUgRAEPerIU returns the µg of retinol activity equivalents (RAE) in one IU of the form of vitamin A.
+func VitaminAUgRAEPerIU(form VitaminAForm) float64 {
+	switch form {
+	case SupplementalBetaCarotene:
+		return 0.15
+	case DietaryBetaCarotene:
+		return 0.05
+	case DietaryAlphaCarotene:
+		return 0.025
+	default:
+		return 0.3
+	}
+}
+
+// VitaminAIUToUgRAE converts vitamin A of the form provided from IU to µg RAE.
+func VitaminAIUToUgRAE(iu float64, form VitaminAForm) float64 {
+	return iu * VitaminAUgRAEPerIU(form)
+}
+
+// VitaminAUgRAEToIU converts vitamin A of the form provided from µg RAE to IU.
+func VitaminAUgRAEToIU(ugRAE float64, form VitaminAForm) float64 {
+	return ugRAE / VitaminAUgRAEPerIU(form)
+}
+
+// VitaminEForm is the form of vitamin E an IU value refers to.
+type VitaminEForm int
+
+const (
+	// NaturalVitaminE is RRR-alpha-tocopherol, labeled d-alpha-tocopherol.
+	NaturalVitaminE VitaminEForm = iota
+
+	// SyntheticVitaminE is all-rac-alpha-tocopherol, labeled dl-alpha-tocopherol.
+	SyntheticVitaminE
+)
+
+// VitaminEMgPerIU returns the mg of alpha-tocopherol in one IU of the form of vitamin E.
+func VitaminEMgPerIU(form VitaminEForm) float64 {
+	if form == SyntheticVitaminE {
+		return 0.45
+	}
+	return 0.67
+}
+
+// VitaminEIUToMg converts vitamin E of the form provided from IU to mg of alpha-tocopherol.
+func VitaminEIUToMg(iu float64, form VitaminEForm) float64 {
+	return iu * VitaminEMgPerIU(form)
+}
+
+// VitaminEMgToIU converts vitamin E of the form provided from mg of alpha-tocopherol to IU.
+func VitaminEMgToIU(mg float64, form VitaminEForm) float64 {
+	return mg / VitaminEMgPerIU(form)
+}
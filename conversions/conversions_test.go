@@ -0,0 +1,41 @@
+package conversions_test
+
+import (
+	"github.com/burke/gocronometer/conversions"
+	"math"
+	"testing"
+)
+
+func TestVitaminD(t *testing.T) {
+	if ug := conversions.VitaminDIUToUg(400); ug != 10 {
+		t.Fatalf("expected 400 IU to be 10 µg but found %f", ug)
+	}
+
+	if iu := conversions.VitaminDUgToIU(15); iu != 600 {
+		t.Fatalf("expected 15 µg to be 600 IU but found %f", iu)
+	}
+}
+
+func TestVitaminA(t *testing.T) {
+	tests := []struct {
+		form     conversions.VitaminAForm
+		expected float64
+	}{
+		{conversions.Retinol, 300},
+		{conversions.SupplementalBetaCarotene, 150},
+		{conversions.DietaryBetaCarotene, 50},
+		{conversions.DietaryAlphaCarotene, 25},
+	}
+
+	for _, test := range tests {
+		if ugRAE := conversions.VitaminAIUToUgRAE(1000, test.form); math.Abs(ugRAE-test.expected) > 1e-9 {
+			t.Fatalf("expected 1000 IU of form %d to be %f µg RAE but found %f", test.form, test.expected, ugRAE)
+		}
+	}
+}
+
+func TestVitaminE(t *testing.T) {
+	if mg := conversions.VitaminEIUToMg(100, conversions.SyntheticVitaminE); math.Abs(mg-45) > 1e-9 {
+		t.Fatalf("expected 100 IU of synthetic vitamin E to be 45 mg but found %f", mg)
+	}
+}
@@ -0,0 +1,244 @@
+// Package cronsync periodically exports recent records from Cronometer and detects the records that are new since
+// the previous export, notifying webhooks of them so downstream automations can react to fresh data.
+package cronsync
+
+import (
+	"context"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The following are the defaults of the Options.
+const (
+	DefaultDays     = 7
+	DefaultInterval = 15 * time.Minute
+)
+
+// Exporter exports parsed records from Cronometer. It is satisfied by *gocronometer.Client, which must be logged in
+// before syncing.
+type Exporter interface {
+	ExportServingsParsedWithLocation(ctx context.Context, startDate time.Time, endDate time.Time, location *time.Location) (gocronometer.ServingRecords, error)
+	ExportExercisesParsedWithLocation(ctx context.Context, startDate time.Time, endDate time.Time, location *time.Location) (gocronometer.ExerciseRecords, error)
+	ExportBiometricRecordsParsedWithLocation(ctx context.Context, startDate time.Time, endDate time.Time, location *time.Location) (gocronometer.BiometricRecords, error)
+}
+
+// Options configures a Syncer. Zero values revert to the defaults.
+type Options struct {
+	// Location is the location of the exported records. Defaults to UTC.
+	Location *time.Location
+
+	// Days is the number of days up to and including today that are exported on each sync. Records logged or edited
+	// further in the past are not detected. Defaults to DefaultDays.
+	Days int
+
+	// Interval is the time between syncs when running. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// Webhooks are notified of the new records of each sync.
+	Webhooks []Webhook
+
+	// HTTPClient is used to deliver the webhooks. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// NotifyInitial notifies the webhooks of every record found by the first sync. By default the first sync only
+	// establishes which records have been seen, so restarting the syncer does not notify the webhooks again.
+	NotifyInitial bool
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// Progress receives a completed chunk for each of the servings, exercises and biometrics exports of a sync.
+	// The bytes downloaded are reported by the Progress of the gocronometer.Client. Progress is not reported when nil.
+	Progress gocronometer.Progress
+
+	// Logger receives the records exported and found new by each sync and the failed webhook deliveries at the debug
+	// level. Requests to Cronometer are logged by the Logger of the gocronometer.Client. Nothing is logged when nil.
+	Logger *slog.Logger
+}
+
+// Changes are the records found by a sync that were not found by the previous sync.
+type Changes struct {
+	Servings   gocronometer.ServingRecords
+	Exercises  gocronometer.ExerciseRecords
+	Biometrics gocronometer.BiometricRecords
+}
+
+// Empty returns true if there are no new records.
+func (c Changes) Empty() bool {
+	return len(c.Servings) == 0 && len(c.Exercises) == 0 && len(c.Biometrics) == 0
+}
+
+// Syncer detects new records in Cronometer. A Syncer is not safe for concurrent use.
+type Syncer struct {
+	exporter Exporter
+	opts     Options
+	synced   bool
+
+	servings   gocronometer.ServingRecords
+	exercises  gocronometer.ExerciseRecords
+	biometrics gocronometer.BiometricRecords
+}
+
+// NewSyncer creates a Syncer exporting from the exporter. If opts is nil the default values are utilized.
+func NewSyncer(exporter Exporter, opts *Options) *Syncer {
+	s := &Syncer{exporter: exporter}
+	if opts != nil {
+		s.opts = *opts
+	}
+
+	if s.opts.Location == nil {
+		s.opts.Location = time.UTC
+	}
+	if s.opts.Days <= 0 {
+		s.opts.Days = DefaultDays
+	}
+	if s.opts.Interval <= 0 {
+		s.opts.Interval = DefaultInterval
+	}
+	if s.opts.HTTPClient == nil {
+		s.opts.HTTPClient = http.DefaultClient
+	}
+	if s.opts.Now == nil {
+		s.opts.Now = time.Now
+	}
+
+	return s
+}
+
+// Sync exports the recent records and returns those not found by the previous sync, notifying the webhooks of them.
+// The records are remembered even if a webhook fails, so failed deliveries are reported in the error but not retried.
+func (s *Syncer) Sync(ctx context.Context) (Changes, error) {
+	end := s.opts.Now().In(s.opts.Location)
+	start := end.AddDate(0, 0, -(s.opts.Days - 1))
+
+	servings, err := s.exporter.ExportServingsParsedWithLocation(ctx, start, end, s.opts.Location)
+	if err != nil {
+		return Changes{}, fmt.Errorf("exporting servings: %w", err)
+	}
+	s.chunkCompleted(1)
+	exercises, err := s.exporter.ExportExercisesParsedWithLocation(ctx, start, end, s.opts.Location)
+	if err != nil {
+		return Changes{}, fmt.Errorf("exporting exercises: %w", err)
+	}
+	s.chunkCompleted(2)
+	biometrics, err := s.exporter.ExportBiometricRecordsParsedWithLocation(ctx, start, end, s.opts.Location)
+	if err != nil {
+		return Changes{}, fmt.Errorf("exporting biometrics: %w", err)
+	}
+	s.chunkCompleted(3)
+
+	changes := Changes{
+		Servings:   newRecords(s.servings, servings, servingKey),
+		Exercises:  newRecords(s.exercises, exercises, exerciseKey),
+		Biometrics: newRecords(s.biometrics, biometrics, biometricKey),
+	}
+	s.servings, s.exercises, s.biometrics = servings, exercises, biometrics
+	s.debug(ctx, "synced",
+		"start", start.Format("2006-01-02"), "end", end.Format("2006-01-02"),
+		"servings", len(servings), "exercises", len(exercises), "biometrics", len(biometrics),
+		"newServings", len(changes.Servings), "newExercises", len(changes.Exercises), "newBiometrics", len(changes.Biometrics),
+		"initial", !s.synced)
+
+	initial := !s.synced
+	s.synced = true
+	if initial && !s.opts.NotifyInitial {
+		return changes, nil
+	}
+
+	return changes, s.notify(ctx, changes)
+}
+
+// debug logs the message at the debug level when the options have a Logger.
+func (s *Syncer) debug(ctx context.Context, msg string, args ...any) {
+	if s.opts.Logger != nil {
+		s.opts.Logger.DebugContext(ctx, msg, args...)
+	}
+}
+
+// chunkCompleted reports the completed exports of a sync.
+func (s *Syncer) chunkCompleted(completed int) {
+	if s.opts.Progress != nil {
+		s.opts.Progress.ChunkCompleted(completed, 3)
+	}
+}
+
+// Run syncs every Interval until the context is done, calling onSync with the result of each sync if it is not nil.
+// Failed syncs do not stop the syncer. The error of the context is returned.
+func (s *Syncer) Run(ctx context.Context, onSync func(Changes, error)) error {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := s.Sync(ctx)
+		if onSync != nil {
+			onSync(changes, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// notify delivers the changes to every webhook, returning the errors of the failed deliveries.
+func (s *Syncer) notify(ctx context.Context, changes Changes) error {
+	var failures []string
+	for _, w := range s.opts.Webhooks {
+		if err := w.deliver(ctx, s.opts.HTTPClient, changes); err != nil {
+			s.debug(ctx, "webhook delivery failed", "error", err)
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("delivering webhooks: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// The following keys identify records between syncs. The raw rows are not compared and the recorded times are
+// compared as instants.
+
+func servingKey(r gocronometer.ServingRecord) gocronometer.ServingRecord {
+	r.RecordedTime = r.RecordedTime.UTC()
+	r.Raw = nil
+	return r
+}
+
+func exerciseKey(r gocronometer.ExerciseRecord) gocronometer.ExerciseRecord {
+	r.RecordedTime = r.RecordedTime.UTC()
+	r.Raw = nil
+	return r
+}
+
+func biometricKey(r gocronometer.BiometricRecord) gocronometer.BiometricRecord {
+	r.RecordedTime = r.RecordedTime.UTC()
+	r.Raw = nil
+	return r
+}
+
+// newRecords returns the records of current that are not in previous. A record found several times is new for each
+// time it is found beyond the number of times it was previously found.
+func newRecords[S ~[]E, E any, K comparable](previous S, current S, key func(E) K) S {
+	seen := make(map[K]int)
+	for _, r := range previous {
+		seen[key(r)]++
+	}
+
+	var found S
+	for _, r := range current {
+		k := key(r)
+		if seen[k] > 0 {
+			seen[k]--
+			continue
+		}
+		found = append(found, r)
+	}
+	return found
+}
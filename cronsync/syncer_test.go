@@ -0,0 +1,94 @@
+package cronsync_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/cronsync"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeExporter returns the records it holds regardless of the dates requested.
+type fakeExporter struct {
+	servings   gocronometer.ServingRecords
+	biometrics gocronometer.BiometricRecords
+}
+
+func (f *fakeExporter) ExportServingsParsedWithLocation(context.Context, time.Time, time.Time, *time.Location) (gocronometer.ServingRecords, error) {
+	return f.servings, nil
+}
+
+func (f *fakeExporter) ExportExercisesParsedWithLocation(context.Context, time.Time, time.Time, *time.Location) (gocronometer.ExerciseRecords, error) {
+	return nil, nil
+}
+
+func (f *fakeExporter) ExportBiometricRecordsParsedWithLocation(context.Context, time.Time, time.Time, *time.Location) (gocronometer.BiometricRecords, error) {
+	return f.biometrics, nil
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	exporter := &fakeExporter{servings: gocronometer.ServingRecords{{FoodName: "Eggs"}}}
+	syncer := cronsync.NewSyncer(exporter, nil)
+
+	changes, err := syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes.Servings) != 1 {
+		t.Fatalf("expected the first sync to find every record but found %+v", changes)
+	}
+
+	// The same food logged a second time is new.
+	exporter.servings = append(exporter.servings, gocronometer.ServingRecord{FoodName: "Eggs"})
+	exporter.biometrics = gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}}
+
+	changes, err = syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes.Servings) != 1 || len(changes.Biometrics) != 1 {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+
+	changes, err = syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changes.Empty() {
+		t.Fatalf("expected no changes but found %+v", changes)
+	}
+}
+
+func TestSyncer_Progress(t *testing.T) {
+	var completed []int
+	syncer := cronsync.NewSyncer(&fakeExporter{}, &cronsync.Options{Progress: gocronometer.ProgressFuncs{
+		ChunkCompletedFunc: func(c int, total int) {
+			completed = append(completed, c)
+		},
+	}})
+
+	if _, err := syncer.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(completed) != 3 || completed[2] != 3 {
+		t.Fatalf("expected a report for each export but found %v", completed)
+	}
+}
+
+func TestSyncer_Logger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	syncer := cronsync.NewSyncer(&fakeExporter{servings: gocronometer.ServingRecords{{FoodName: "Eggs"}}}, &cronsync.Options{Logger: logger})
+
+	if _, err := syncer.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), "msg=synced") || !strings.Contains(buf.String(), "servings=1") || !strings.Contains(buf.String(), "newServings=1") {
+		t.Fatalf("unexpected log: %s", buf.String())
+	}
+}
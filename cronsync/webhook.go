@@ -0,0 +1,133 @@
+package cronsync
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Event is the kind of records a webhook is notified of.
+type Event string
+
+// The following are the events webhooks are notified of.
+const (
+	EventServings   Event = "servings"
+	EventExercises  Event = "exercises"
+	EventBiometrics Event = "biometrics"
+)
+
+// The following are the headers of webhook requests.
+const (
+	// EventHeader holds the Event of the request.
+	EventHeader = "X-Gocronometer-Event"
+
+	// SignatureHeader holds "sha256=" followed by the hex encoded HMAC-SHA256 of the body, keyed with the secret of
+	// the webhook. It is only set when the webhook has a secret.
+	SignatureHeader = "X-Gocronometer-Signature"
+)
+
+// Webhook is a URL notified of new records. Each sync with new records sends a JSON Payload with a POST request for
+// each event with new records.
+type Webhook struct {
+	URL string
+
+	// Secret signs the requests so the receiver can verify they came from the syncer. See SignatureHeader.
+	Secret string
+
+	// Events are the events the webhook is notified of. All events are notified when empty.
+	Events []Event
+}
+
+// Payload is the body of a webhook request. Only the records of the event are set.
+type Payload struct {
+	Event      Event                         `json:"event"`
+	Servings   gocronometer.ServingRecords   `json:"servings,omitempty"`
+	Exercises  gocronometer.ExerciseRecords  `json:"exercises,omitempty"`
+	Biometrics gocronometer.BiometricRecords `json:"biometrics,omitempty"`
+}
+
+// wants returns true if the webhook is notified of the event.
+func (w Webhook) wants(event Event) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver sends a request for each event with new records.
+func (w Webhook) deliver(ctx context.Context, client *http.Client, changes Changes) error {
+	payloads := []Payload{
+		{Event: EventServings, Servings: changes.Servings},
+		{Event: EventExercises, Exercises: changes.Exercises},
+		{Event: EventBiometrics, Biometrics: changes.Biometrics},
+	}
+
+	for _, p := range payloads {
+		if len(p.Servings) == 0 && len(p.Exercises) == 0 && len(p.Biometrics) == 0 || !w.wants(p.Event) {
+			continue
+		}
+		if err := w.send(ctx, client, p); err != nil {
+			return fmt.Errorf("%s %s: %w", w.URL, p.Event, err)
+		}
+	}
+	return nil
+}
+
+// send posts the payload.
+func (w Webhook) send(ctx context.Context, client *http.Client, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("encoding payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(p.Event))
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign computes the SignatureHeader of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the signature, the value of the SignatureHeader, is valid for the body. It is for
+// use by webhook receivers written in Go.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,73 @@
+package cronsync_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/cronsync"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhook(t *testing.T) {
+	var payloads []cronsync.Payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !cronsync.VerifySignature("secret", body, r.Header.Get(cronsync.SignatureHeader)) {
+			t.Errorf("invalid signature %s", r.Header.Get(cronsync.SignatureHeader))
+		}
+
+		var p cronsync.Payload
+		if err := json.Unmarshal(body, &p); err != nil {
+			t.Error(err)
+		}
+		if string(p.Event) != r.Header.Get(cronsync.EventHeader) {
+			t.Errorf("expected the event header to match the payload")
+		}
+		payloads = append(payloads, p)
+	}))
+	defer server.Close()
+
+	exporter := &fakeExporter{}
+	syncer := cronsync.NewSyncer(exporter, &cronsync.Options{
+		Webhooks: []cronsync.Webhook{{URL: server.URL, Secret: "secret", Events: []cronsync.Event{cronsync.EventBiometrics}}},
+	})
+
+	// The first sync only establishes the records seen.
+	exporter.biometrics = gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}}
+	if _, err := syncer.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(payloads) != 0 {
+		t.Fatalf("expected no notifications for the first sync but found %+v", payloads)
+	}
+
+	exporter.servings = gocronometer.ServingRecords{{FoodName: "Eggs"}}
+	exporter.biometrics = append(exporter.biometrics, gocronometer.BiometricRecord{Metric: "Weight", Amount: 79.5})
+	if _, err := syncer.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(payloads) != 1 || payloads[0].Event != cronsync.EventBiometrics || payloads[0].Biometrics[0].Amount != 79.5 {
+		t.Fatalf("expected only the new biometric to be notified but found %+v", payloads)
+	}
+}
+
+func TestWebhook_Failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	exporter := &fakeExporter{servings: gocronometer.ServingRecords{{FoodName: "Eggs"}}}
+	syncer := cronsync.NewSyncer(exporter, &cronsync.Options{
+		Webhooks:      []cronsync.Webhook{{URL: server.URL}},
+		NotifyInitial: true,
+	})
+
+	if _, err := syncer.Sync(context.Background()); err == nil {
+		t.Fatal("expected the failed delivery to be reported")
+	}
+}
@@ -0,0 +1,103 @@
+package gocronometer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// WriteServingsCSV writes the servings in the format of the Cronometer servings export, so they can be parsed again
+// by ParseServings. The Source and Food ID columns are only written when a serving has a value for them.
+func WriteServingsCSV(w io.Writer, servings ServingRecords) error {
+	var source, foodID bool
+	for _, s := range servings {
+		source = source || s.Source != ""
+		foodID = foodID || s.FoodID != ""
+	}
+
+	headers := []string{"Day", "Time", "Group", "Food Name", "Amount"}
+	if source {
+		headers = append(headers, "Source")
+	}
+	if foodID {
+		headers = append(headers, "Food ID")
+	}
+	for _, n := range nutrients {
+		headers = append(headers, n.Header)
+	}
+	headers = append(headers, "Category")
+
+	rows := make([][]string, 0, len(servings))
+	for _, s := range servings {
+		row := []string{s.Day.String(), formatTime(s.RecordedTime, s.HasTime), s.Group, s.FoodName,
+			formatFloat(s.QuantityValue) + " " + s.QuantityUnits}
+		if source {
+			row = append(row, s.Source)
+		}
+		if foodID {
+			row = append(row, s.FoodID)
+		}
+		for _, n := range nutrients {
+			row = append(row, formatFloat(n.Value(s)))
+		}
+		rows = append(rows, append(row, s.Category))
+	}
+
+	return writeCSV(w, headers, rows)
+}
+
+// WriteExerciseCSV writes the exercises in the format of the Cronometer exercises export, so they can be parsed again
+// by ParseExercises.
+func WriteExerciseCSV(w io.Writer, exercises ExerciseRecords) error {
+	rows := make([][]string, 0, len(exercises))
+	for _, e := range exercises {
+		rows = append(rows, []string{e.Day.String(), formatTime(e.RecordedTime, e.HasTime), e.Exercise,
+			formatFloat(e.Minutes), formatFloat(e.CaloriesBurned)})
+	}
+
+	return writeCSV(w, exerciseColumns, rows)
+}
+
+// WriteBiometricsCSV writes the biometrics in the format of the Cronometer biometrics export, so they can be parsed
+// again by ParseBiometrics.
+func WriteBiometricsCSV(w io.Writer, biometrics BiometricRecords) error {
+	rows := make([][]string, 0, len(biometrics))
+	for _, b := range biometrics {
+		rows = append(rows, []string{b.Day.String(), formatTime(b.RecordedTime, b.HasTime), b.Metric, b.Unit,
+			formatFloat(b.Amount)})
+	}
+
+	return writeCSV(w, biometricColumns, rows)
+}
+
+// writeCSV writes the headers followed by the rows.
+func writeCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return fmt.Errorf("writing headers: %w", err)
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("writing rows: %w", err)
+	}
+
+	return nil
+}
+
+// formatTime formats the time of day as found in the Time column of the exports. Records without a time have an empty
+// Time column.
+func formatTime(t time.Time, hasTime bool) string {
+	if !hasTime {
+		return ""
+	}
+	if t.Second() != 0 {
+		return t.Format("15:04:05")
+	}
+	return t.Format("15:04")
+}
+
+// formatFloat formats the value with the fewest digits needed to parse it again.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,80 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteServingsCSV(t *testing.T) {
+	servings, err := gocronometer.ParseServings(strings.NewReader(
+		"Day,Time,Group,Food Name,Amount,Energy (kcal),Protein (g),Vitamin K (µg),Category\n" +
+			"2021-06-01,08:30,Breakfast,\"Eggs, scrambled\",2.00 large,140,12.5,0.3,Dairy and Egg Products\n" +
+			"2021-06-01,,Dinner,Rice,1.5 cup,300,6,,Cereal Grains and Pasta\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := gocronometer.WriteServingsCSV(&buf, servings); err != nil {
+		t.Fatal(err)
+	}
+
+	headers, err := gocronometer.ReadHeaders(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report := gocronometer.ValidateServingsHeaders(headers); !report.OK() {
+		t.Fatalf("expected the headers of a servings export but found %s", report)
+	}
+
+	parsed, err := gocronometer.ParseServings(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != len(servings) {
+		t.Fatalf("expected %d servings but found %d", len(servings), len(parsed))
+	}
+	for i := range servings {
+		if parsed[i] != servings[i] {
+			t.Fatalf("expected %+v but found %+v", servings[i], parsed[i])
+		}
+	}
+	if parsed[1].HasTime || !parsed[0].RecordedTime.Equal(time.Date(2021, 6, 1, 8, 30, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected times: %+v", parsed)
+	}
+}
+
+func TestWriteExerciseCSV(t *testing.T) {
+	raw := "Day,Time,Exercise,Minutes,Calories Burned\n2021-06-01,18:00,Walking,30,-120.5\n2021-06-02,,\"Yoga, Hatha\",45,-150\n"
+	exercises, err := gocronometer.ParseExercises(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := gocronometer.WriteExerciseCSV(&buf, exercises); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != raw {
+		t.Fatalf("expected %q but found %q", raw, buf.String())
+	}
+}
+
+func TestWriteBiometricsCSV(t *testing.T) {
+	raw := "Day,Time,Metric,Unit,Amount\n2021-06-01,07:00:30,Weight,kg,80.25\n2021-06-01,,Heart Rate,bpm,62\n"
+	biometrics, err := gocronometer.ParseBiometrics(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := gocronometer.WriteBiometricsCSV(&buf, biometrics); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != raw {
+		t.Fatalf("expected %q but found %q", raw, buf.String())
+	}
+}
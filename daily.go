@@ -0,0 +1,165 @@
+package gocronometer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DailyNutritionRecord is a day of the daily nutrition export, holding the nutrient totals of the day.
+type DailyNutritionRecord struct {
+	Day Date
+	Raw *RawRow
+
+	// Completed is whether the user marked the day as complete in Cronometer. Days that are not completed may be
+	// partially logged, so they are best excluded from averages.
+	Completed bool
+
+	// Totals holds the nutrient totals of the day in the nutrient fields of a serving. The other fields are unset.
+	Totals ServingRecord
+}
+
+type DailyNutritionRecords []DailyNutritionRecord
+
+// DailyNutritionTarget is a target row of the daily nutrition export, found alongside the totals of the days in some
+// reports with a label such as "Targets" in place of the date.
+type DailyNutritionTarget struct {
+	// Label is the value of the Date column of the row.
+	Label string
+	Raw   *RawRow
+
+	// Targets holds the nutrient targets of the row in the nutrient fields of a serving. The other fields are unset.
+	Targets ServingRecord
+}
+
+type DailyNutritionTargets []DailyNutritionTarget
+
+// ParseDailyNutrition parses the raw CSV daily nutrition export configured by the options provided. The Date column of
+// the export is parsed as the Day. Target rows are skipped, and are parsed with ParseDailyNutritionWithTargets.
+func ParseDailyNutrition(rawCSVReader io.Reader, options ...ParseOption) (DailyNutritionRecords, error) {
+	daily, _, err := ParseDailyNutritionWithTargets(rawCSVReader, options...)
+	return daily, err
+}
+
+// ParseDailyNutritionWithTargets parses the raw CSV daily nutrition export as ParseDailyNutrition, also returning its
+// target rows. Rows are targets when their Date column contains "target" without regard to case, such as "Targets" or
+// "Max Target", rather than a date. The targets are returned in the order of the export.
+func ParseDailyNutritionWithTargets(rawCSVReader io.Reader, options ...ParseOption) (DailyNutritionRecords, DailyNutritionTargets, error) {
+	opts := newParseOptions(options...)
+
+	// The daily export names its day column Date, which is mapped without altering the mappings of the caller.
+	mapping := map[string]string{"Date": "Day"}
+	for from, to := range opts.HeaderMapping {
+		mapping[from] = to
+	}
+	opts.HeaderMapping = mapping
+
+	rows, err := parseLabeledExport(rawCSVReader, opts, isTargetLabel, opts.dailyNutritionRowColumn,
+		func(row *dailyNutritionRow, info rowInfo) {
+			row.record.Day, row.record.Raw, row.label = info.day, info.raw, info.label
+		})
+	if rows == nil && err != nil {
+		return nil, nil, err
+	}
+
+	daily := make(DailyNutritionRecords, 0, len(rows))
+	targets := make(DailyNutritionTargets, 0)
+	for _, row := range rows {
+		if row.label != "" {
+			targets = append(targets, DailyNutritionTarget{Label: row.label, Raw: row.record.Raw, Targets: row.record.Totals})
+			continue
+		}
+		daily = append(daily, row.record)
+	}
+
+	// The row errors of lenient mode are returned with the rows parsed.
+	return daily, targets, err
+}
+
+// dailyNutritionRow is a row of the daily nutrition export, either the record of a day or a target row with its label.
+type dailyNutritionRow struct {
+	record DailyNutritionRecord
+	label  string
+}
+
+// isTargetLabel returns whether the value of the Date column labels a target row.
+func isTargetLabel(date string) bool {
+	return strings.Contains(strings.ToLower(date), "target")
+}
+
+// dailyNutritionRowColumn returns the parser of the daily nutrition column with the header for the rows of the export.
+func (o *ParseOptions) dailyNutritionRowColumn(header string) columnParser[dailyNutritionRow] {
+	parse := o.dailyNutritionColumn(header)
+	if parse == nil {
+		return nil
+	}
+
+	return func(row *dailyNutritionRow, v string) error {
+		return parse(&row.record, v)
+	}
+}
+
+// dailyNutritionColumn returns the parser of the daily nutrition column with the header, or nil for unknown columns.
+func (o *ParseOptions) dailyNutritionColumn(header string) columnParser[DailyNutritionRecord] {
+	if header == "Completed" {
+		return func(daily *DailyNutritionRecord, v string) error {
+			if strings.TrimSpace(v) == "" {
+				return nil
+			}
+			completed, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return fmt.Errorf("parsing completed: %w", err)
+			}
+			daily.Completed = completed
+			return nil
+		}
+	}
+
+	if n, ok := nutrientsByHeader[header]; ok {
+		return func(daily *DailyNutritionRecord, v string) error {
+			f, err := o.parseNutrientFloat(v, n.Name)
+			if err != nil {
+				return err
+			}
+			n.Set(&daily.Totals, f)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// CompletedDays returns the days marked as complete, to exclude the servings of incomplete days from analyses:
+//
+//	completed := daily.CompletedDays()
+//	servings = servings.Filter(func(s gocronometer.ServingRecord) bool { return completed[s.Day] })
+func (d DailyNutritionRecords) CompletedDays() map[Date]bool {
+	completed := make(map[Date]bool)
+	for _, daily := range d {
+		if daily.Completed {
+			completed[daily.Day] = true
+		}
+	}
+
+	return completed
+}
+
+// ExportDailyNutritionParsed exports the daily nutrition within the date range and parses it into a go struct. Only
+// the YYYY-mm-dd is utilized of startDate and endDate.
+func (c *Client) ExportDailyNutritionParsed(ctx context.Context, startDate time.Time, endDate time.Time) (DailyNutritionRecords, error) {
+	raw, err := c.ExportDailyNutrition(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("retreiving raw data: %w", err)
+	}
+
+	daily, err := ParseDailyNutrition(strings.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("parsing raw data: %w", err)
+	}
+	c.debug(ctx, "parsed export", "export", "daily nutrition", "records", len(daily), "bytes", len(raw))
+
+	return daily, nil
+}
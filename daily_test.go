@@ -0,0 +1,98 @@
+package gocronometer_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/gocronometertest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const dailyNutritionCSV = "Date,Energy (kcal),Protein (g),Fat (g),Completed\n" +
+	"2021-06-01,2105.3,120.5,80,true\n" +
+	"2021-06-02,850,40,30,false\n" +
+	"2021-06-03,0,0,0,\n"
+
+func TestParseDailyNutrition(t *testing.T) {
+	daily, err := gocronometer.ParseDailyNutrition(strings.NewReader(dailyNutritionCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(daily) != 3 || !daily[0].Completed || daily[1].Completed || daily[2].Completed {
+		t.Fatalf("unexpected completed flags: %+v", daily)
+	}
+
+	june1 := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	if daily[0].Day != june1 || daily[0].Totals.EnergyKcal != 2105.3 || daily[0].Totals.ProteinG != 120.5 {
+		t.Fatalf("unexpected totals: %+v", daily[0])
+	}
+
+	completed := daily.CompletedDays()
+	if len(completed) != 1 || !completed[june1] {
+		t.Fatalf("expected only June 1 to be completed but found %v", completed)
+	}
+
+	if _, err := gocronometer.ParseDailyNutrition(strings.NewReader("Date,Completed\n2021-06-01,maybe\n")); err == nil {
+		t.Fatal("expected an error for an invalid completed flag")
+	}
+}
+
+func TestParseDailyNutritionWithTargets(t *testing.T) {
+	export := "Date,Energy (kcal),Protein (g),Fat (g),Completed\n" +
+		"2021-06-01,2105.3,120.5,80,true\n" +
+		"Targets,2200,130,,\n" +
+		"2021-06-02,850,40,30,false\n" +
+		"Max Target,2500,,90,\n"
+
+	daily, targets, err := gocronometer.ParseDailyNutritionWithTargets(strings.NewReader(export), gocronometer.WithKeepRaw())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(daily) != 2 || daily[1].Day != (gocronometer.Date{Year: 2021, Month: time.June, Day: 2}) || daily[1].Totals.EnergyKcal != 850 {
+		t.Fatalf("unexpected daily nutrition: %+v", daily)
+	}
+	if len(targets) != 2 || targets[0].Label != "Targets" || targets[0].Targets.EnergyKcal != 2200 || targets[0].Targets.ProteinG != 130 {
+		t.Fatalf("unexpected targets: %+v", targets)
+	}
+	if targets[1].Label != "Max Target" || targets[1].Targets.FatG != 90 {
+		t.Fatalf("unexpected target: %+v", targets[1])
+	}
+	if energy, ok := targets[1].Raw.Get("Energy (kcal)"); !ok || energy != "2500" {
+		t.Fatalf("expected the raw energy target 2500 but found %q", energy)
+	}
+
+	// ParseDailyNutrition skips the targets rather than failing on their label.
+	daily, err = gocronometer.ParseDailyNutrition(strings.NewReader(export))
+	if err != nil || len(daily) != 2 {
+		t.Fatalf("expected the 2 days but found %+v, %v", daily, err)
+	}
+
+	// Other labels are still invalid dates.
+	if _, err := gocronometer.ParseDailyNutrition(strings.NewReader("Date,Energy (kcal)\nTotal,2105\n")); err == nil {
+		t.Fatal("expected an error for an invalid date")
+	}
+}
+
+func TestClient_ExportDailyNutritionParsed(t *testing.T) {
+	server := gocronometertest.NewServer("user", "pass")
+	defer server.Close()
+	server.SetExport("dailySummary", dailyNutritionCSV)
+
+	client := server.Client(nil)
+	if err := client.Login(context.Background(), "user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	daily, err := client.ExportDailyNutritionParsed(context.Background(), start, start.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(daily) != 3 || !daily[0].Completed {
+		t.Fatalf("unexpected daily nutrition: %+v", daily)
+	}
+}
@@ -0,0 +1,98 @@
+package gocronometer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateFormat is the format of the dates found in Cronometer exports.
+const DateFormat = "2006-01-02"
+
+// Date is a civil date without a time or location. It is used to represent the Day column of the exports as shown in
+// Cronometer, independent of any time zone conversion applied to the recorded time.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDate parses a date in the DateFormat used by the exports.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DateFormat, strings.TrimSpace(s))
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date format %q: %w", s, err)
+	}
+
+	return DateOf(t), nil
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// String returns the date in DateFormat.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero returns true if d is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Time returns midnight of the date in the location provided. A nil location is treated as UTC.
+func (d Date) Time(location *time.Location) time.Time {
+	if location == nil {
+		location = time.UTC
+	}
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, location)
+}
+
+// AddDays returns the date n days after d. A negative n returns a date before d.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// Before returns true if d is before o.
+func (d Date) Before(o Date) bool {
+	if d.Year != o.Year {
+		return d.Year < o.Year
+	}
+	if d.Month != o.Month {
+		return d.Month < o.Month
+	}
+	return d.Day < o.Day
+}
+
+// After returns true if d is after o.
+func (d Date) After(o Date) bool {
+	return o.Before(d)
+}
+
+// MarshalText implements encoding.TextMarshaler using DateFormat. The zero date is marshaled as empty text so it
+// survives a round trip.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using DateFormat. Empty text is unmarshaled as the zero date.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
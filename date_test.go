@@ -0,0 +1,65 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDate_AddDays(t *testing.T) {
+	d, err := gocronometer.ParseDate("2020-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.AddDays(1).String(); got != "2020-02-29" {
+		t.Fatalf("expected 2020-02-29 but found %s", got)
+	}
+
+	if got := d.AddDays(2).String(); got != "2020-03-01" {
+		t.Fatalf("expected 2020-03-01 but found %s", got)
+	}
+
+	if !d.Before(d.AddDays(1)) || !d.After(d.AddDays(-1)) {
+		t.Fatalf("date comparisons failed")
+	}
+}
+
+func TestParseServingsExport_Day(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount\n" +
+		"2021-06-01,00:30,Eggs,1 large\n"
+
+	// A location far east of UTC moves the instant to the previous UTC day but the day must match the export.
+	location := time.FixedZone("UTC+14", 14*60*60)
+	servings, err := gocronometer.ParseServingsExport(strings.NewReader(raw), location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	if servings[0].Day != expected {
+		t.Fatalf("expected day %s but found %s", expected, servings[0].Day)
+	}
+
+	if servings[0].RecordedTime.UTC().Day() != 31 {
+		t.Fatalf("expected the UTC instant to fall on the 31st but found %s", servings[0].RecordedTime.UTC())
+	}
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	for _, d := range []gocronometer.Date{{}, {Year: 2021, Month: time.June, Day: 1}} {
+		text, err := d.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var parsed gocronometer.Date
+		if err := parsed.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+		if parsed != d {
+			t.Fatalf("expected %v after a round trip but found %v", d, parsed)
+		}
+	}
+}
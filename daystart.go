@@ -0,0 +1,49 @@
+package gocronometer
+
+import (
+	"time"
+)
+
+// DayOf returns the day a record recorded at t belongs to when days start at dayStart, an offset from midnight between
+// zero and 24 hours. Records before dayStart on a calendar day belong to the previous day, so a snack at 01:00 with
+// days starting at 04:00 belongs to the evening before it. The time of day is taken from t in its own location, which
+// should be the location of the user.
+func DayOf(t time.Time, dayStart time.Duration) Date {
+	day := DateOf(t)
+	hour, min, sec := t.Clock()
+	clock := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+	if clock < dayStart {
+		return day.AddDays(-1)
+	}
+
+	return day
+}
+
+// ApplyDayStart moves the servings recorded before dayStart to the previous day, as DayOf, so the daily totals and
+// groupings match a day boundary shifted in Cronometer. Servings without a time keep their day. It is equivalent to
+// parsing the export with WithDayStart.
+func (s ServingRecords) ApplyDayStart(dayStart time.Duration) {
+	for i := range s {
+		if s[i].HasTime {
+			s[i].Day = DayOf(s[i].RecordedTime, dayStart)
+		}
+	}
+}
+
+// ApplyDayStart moves the exercises recorded before dayStart to the previous day as ServingRecords.ApplyDayStart.
+func (e ExerciseRecords) ApplyDayStart(dayStart time.Duration) {
+	for i := range e {
+		if e[i].HasTime {
+			e[i].Day = DayOf(e[i].RecordedTime, dayStart)
+		}
+	}
+}
+
+// ApplyDayStart moves the biometrics recorded before dayStart to the previous day as ServingRecords.ApplyDayStart.
+func (b BiometricRecords) ApplyDayStart(dayStart time.Duration) {
+	for i := range b {
+		if b[i].HasTime {
+			b[i].Day = DayOf(b[i].RecordedTime, dayStart)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseServings_DayStart(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,20:00,Pasta,1 cup,400\n" +
+		"2021-06-02,01:30,Cookies,2 cookie,150\n" +
+		"2021-06-02,04:00,Coffee,1 cup,2\n" +
+		"2021-06-02,,Vitamin D,1 capsule,0\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithDayStart(4*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	june1 := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	june2 := june1.AddDays(1)
+	expected := []gocronometer.Date{june1, june1, june2, june2}
+	for i, s := range servings {
+		if s.Day != expected[i] {
+			t.Fatalf("expected %s on %s but found %s", s.FoodName, expected[i], s.Day)
+		}
+	}
+
+	if s := servings[1].RecordedTime.Format(time.RFC3339); s != "2021-06-02T01:30:00Z" {
+		t.Fatalf("expected the recorded time to be unaffected but found %s", s)
+	}
+
+	// Applying the day start to parsed servings gives the same days, however many times it is applied.
+	parsed, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed.ApplyDayStart(4 * time.Hour)
+	parsed.ApplyDayStart(4 * time.Hour)
+	if !parsed.Equal(servings) {
+		t.Fatalf("expected ApplyDayStart to match WithDayStart")
+	}
+
+	var totals []float64
+	for _, day := range parsed.ByDay() {
+		totals = append(totals, day.Total().EnergyKcal)
+	}
+	if len(totals) != 2 || totals[0] != 550 {
+		t.Fatalf("expected the late night snack in the total of June 1 but found %v", totals)
+	}
+}
@@ -0,0 +1,88 @@
+package gocronometer
+
+import (
+	"sort"
+)
+
+// scale returns the nutrients of the serving multiplied by factor. Only the nutrient fields are set on the result.
+func (s ServingRecord) scale(factor float64) ServingRecord {
+	var scaled ServingRecord
+	for _, n := range nutrients {
+		n.Set(&scaled, n.Value(s)*factor)
+	}
+
+	return scaled
+}
+
+// DensityPer100Kcal returns the nutrients of the serving per 100 kcal of energy, showing how much of each nutrient the
+// serving provides for its energy. Only the nutrient fields are set on the result, and EnergyKcal is always 100. False
+// is returned when the serving provides no energy, as the density is undefined.
+func (s ServingRecord) DensityPer100Kcal() (ServingRecord, bool) {
+	if s.EnergyKcal <= 0 {
+		return ServingRecord{}, false
+	}
+
+	return s.scale(100 / s.EnergyKcal), true
+}
+
+// DensityPer100Kcal returns the nutrients of all the servings per 100 kcal of their combined energy. It is used with
+// GroupBy to calculate the density of each day, or with the servings of a range to calculate the density of the range.
+func (s ServingRecords) DensityPer100Kcal() (ServingRecord, bool) {
+	return s.Total().DensityPer100Kcal()
+}
+
+// DensityPerDollar returns the nutrients of all the servings per unit of their combined cost, where cost returns the
+// cost of each serving, such as its price in dollars. Cronometer does not export the cost of foods, so the costs are
+// most often calculated from the amount of the serving and a price list kept by the caller. False is returned when the
+// servings cost nothing.
+func (s ServingRecords) DensityPerDollar(cost func(ServingRecord) float64) (ServingRecord, bool) {
+	var total float64
+	for _, serving := range s {
+		total += cost(serving)
+	}
+	if total <= 0 {
+		return ServingRecord{}, false
+	}
+
+	return s.Total().scale(1 / total), true
+}
+
+// FoodDensity is the nutrient density of the servings of a food.
+type FoodDensity struct {
+	FoodName string
+
+	// EnergyKcal is the energy of all the servings of the food, showing how much the food contributes to the diet.
+	EnergyKcal float64
+
+	// Per100Kcal are the nutrients of the food per 100 kcal as returned by DensityPer100Kcal.
+	Per100Kcal ServingRecord
+}
+
+// FoodDensities calculates the density of each food of the servings, helping to identify the foods that carry the
+// diet. Foods without energy, such as water, are omitted as their density is undefined. The result is sorted by the
+// energy of each food, highest first, and can be sorted by the density of a nutrient instead:
+//
+//	protein, _ := gocronometer.LookupNutrient("Protein")
+//	sort.Slice(densities, func(i, j int) bool {
+//		return protein.Value(densities[i].Per100Kcal) > protein.Value(densities[j].Per100Kcal)
+//	})
+func (s ServingRecords) FoodDensities() []FoodDensity {
+	densities := make([]FoodDensity, 0)
+	for food, servings := range GroupBy(s, func(s ServingRecord) string { return s.FoodName }) {
+		total := servings.Total()
+		density, ok := total.DensityPer100Kcal()
+		if !ok {
+			continue
+		}
+		densities = append(densities, FoodDensity{FoodName: food, EnergyKcal: total.EnergyKcal, Per100Kcal: density})
+	}
+
+	sort.Slice(densities, func(i, j int) bool {
+		if densities[i].EnergyKcal != densities[j].EnergyKcal {
+			return densities[i].EnergyKcal > densities[j].EnergyKcal
+		}
+		return densities[i].FoodName < densities[j].FoodName
+	})
+
+	return densities
+}
@@ -0,0 +1,66 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+)
+
+func TestServingRecord_DensityPer100Kcal(t *testing.T) {
+	density, ok := gocronometer.ServingRecord{FoodName: "Spinach", EnergyKcal: 23, VitaminKUg: 483, IronMg: 2.7}.DensityPer100Kcal()
+	if !ok {
+		t.Fatal("expected a density for a serving with energy")
+	}
+	if density.EnergyKcal != 100 || math.Abs(density.VitaminKUg-2100) > 1e-9 || math.Abs(density.IronMg-11.73913) > 1e-5 {
+		t.Fatalf("unexpected density: %+v", density)
+	}
+	if density.VitaminKMg != density.VitaminKUg {
+		t.Fatal("expected the deprecated vitamin K field to be kept in sync")
+	}
+
+	if _, ok := (gocronometer.ServingRecord{FoodName: "Water", WaterG: 250}).DensityPer100Kcal(); ok {
+		t.Fatal("expected no density for a serving without energy")
+	}
+}
+
+func TestServingRecords_DensityPerDollar(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Lentils", QuantityValue: 100, EnergyKcal: 350, ProteinG: 25},
+		{FoodName: "Steak", QuantityValue: 200, EnergyKcal: 500, ProteinG: 50},
+	}
+	prices := map[string]float64{"Lentils": 0.005, "Steak": 0.02}
+
+	density, ok := servings.DensityPerDollar(func(s gocronometer.ServingRecord) float64 {
+		return prices[s.FoodName] * s.QuantityValue
+	})
+	if !ok {
+		t.Fatal("expected a density for servings with a cost")
+	}
+	if math.Abs(density.ProteinG-75/4.5) > 1e-9 {
+		t.Fatalf("expected %f g of protein per dollar but found %f", 75/4.5, density.ProteinG)
+	}
+
+	if _, ok := servings.DensityPerDollar(func(gocronometer.ServingRecord) float64 { return 0 }); ok {
+		t.Fatal("expected no density for servings without a cost")
+	}
+}
+
+func TestServingRecords_FoodDensities(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Rice", EnergyKcal: 200, ProteinG: 4},
+		{FoodName: "Eggs", EnergyKcal: 140, ProteinG: 12},
+		{FoodName: "Rice", EnergyKcal: 200, ProteinG: 4},
+		{FoodName: "Water", WaterG: 500},
+	}
+
+	densities := servings.FoodDensities()
+	if len(densities) != 2 {
+		t.Fatalf("expected 2 foods but found %+v", densities)
+	}
+	if densities[0].FoodName != "Rice" || densities[0].EnergyKcal != 400 || densities[0].Per100Kcal.ProteinG != 2 {
+		t.Fatalf("unexpected density of rice: %+v", densities[0])
+	}
+	if densities[1].FoodName != "Eggs" || math.Abs(densities[1].Per100Kcal.ProteinG-12/1.4) > 1e-9 {
+		t.Fatalf("unexpected density of eggs: %+v", densities[1])
+	}
+}
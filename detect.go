@@ -0,0 +1,101 @@
+package gocronometer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ExportType is the type of a Cronometer export.
+type ExportType int
+
+// The following are the types of Cronometer exports.
+const (
+	ExportTypeUnknown ExportType = iota
+	ExportTypeServings
+	ExportTypeExercises
+	ExportTypeBiometrics
+	ExportTypeNotes
+	ExportTypeDailySummary
+)
+
+// String returns the name of the export type as requested from Cronometer, for example "servings".
+func (t ExportType) String() string {
+	switch t {
+	case ExportTypeServings:
+		return "servings"
+	case ExportTypeExercises:
+		return "exercises"
+	case ExportTypeBiometrics:
+		return "biometrics"
+	case ExportTypeNotes:
+		return "notes"
+	case ExportTypeDailySummary:
+		return "dailySummary"
+	default:
+		return fmt.Sprintf("ExportType(%d)", int(t))
+	}
+}
+
+// DetectExportType reads the header row of the raw CSV export to detect its type, so tools can accept any Cronometer
+// export without being told which it is. The header row is consumed from rawCSVReader, so exports that are parsed
+// after detection must be read again. ExportTypeUnknown is returned without an error when the headers are not
+// recognized.
+func DetectExportType(rawCSVReader io.Reader) (ExportType, error) {
+	headers, err := ReadHeaders(rawCSVReader)
+	if err != nil {
+		return ExportTypeUnknown, err
+	}
+
+	return DetectExportTypeFromHeaders(headers), nil
+}
+
+// DetectExportTypeFromHeaders detects the type of an export from its headers. The columns that distinguish each
+// export are checked rather than every column, so exports with added or removed nutrients are still detected.
+func DetectExportTypeFromHeaders(headers []string) ExportType {
+	found := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		found[h] = true
+	}
+
+	switch {
+	case found["Food Name"]:
+		return ExportTypeServings
+	case found["Exercise"] && found["Minutes"]:
+		return ExportTypeExercises
+	case found["Metric"] && found["Amount"]:
+		return ExportTypeBiometrics
+	case found["Note"]:
+		return ExportTypeNotes
+	case found["Date"] && found["Energy (kcal)"]:
+		return ExportTypeDailySummary
+	default:
+		return ExportTypeUnknown
+	}
+}
+
+// Parse detects the type of the raw CSV export and parses it, returning an Export with the slice of that type
+// populated and Kind set. The times are parsed in location unless overridden by the options. Daily summary exports are
+// parsed into DailyNutrition.
+func Parse(rawCSVReader io.Reader, location *time.Location, options ...ParseOption) (Export, error) {
+	data, err := io.ReadAll(newParseOptions(options...).limitReader(rawCSVReader))
+	if err != nil {
+		return Export{}, fmt.Errorf("reading export: %w", err)
+	}
+
+	kind, err := DetectExportType(bytes.NewReader(data))
+	if err != nil {
+		return Export{}, err
+	}
+
+	parse, ok := archiveFiles[kind.String()]
+	if !ok {
+		return Export{Kind: kind}, fmt.Errorf("parsing %s exports is not supported", kind)
+	}
+
+	export := Export{Kind: kind}
+	options = append([]ParseOption{WithLocation(location)}, options...)
+	err = parse(&export, bytes.NewReader(data), options)
+	return export, err
+}
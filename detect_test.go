@@ -0,0 +1,69 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectExportType(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected gocronometer.ExportType
+	}{
+		{"Day,Time,Group,Food Name,Amount,Energy (kcal)\n", gocronometer.ExportTypeServings},
+		{"Day,Time,Exercise,Minutes,Calories Burned\n", gocronometer.ExportTypeExercises},
+		{"Day,Time,Metric,Unit,Amount\n", gocronometer.ExportTypeBiometrics},
+		{"Day,Group,Note\n", gocronometer.ExportTypeNotes},
+		{"Date,Energy (kcal),Protein (g),Completed\n", gocronometer.ExportTypeDailySummary},
+		{"Name,Value\n", gocronometer.ExportTypeUnknown},
+	}
+
+	for _, test := range tests {
+		detected, err := gocronometer.DetectExportType(strings.NewReader(test.raw))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if detected != test.expected {
+			t.Fatalf("expected %s for %q but found %s", test.expected, test.raw, detected)
+		}
+	}
+
+	if _, err := gocronometer.DetectExportType(strings.NewReader("")); err == nil {
+		t.Fatal("expected an error for an empty export")
+	}
+}
+
+func TestParse(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := gocronometer.Parse(strings.NewReader("Day,Time,Metric,Unit,Amount\n2021-06-01,07:00,Weight,kg,80\n"), location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if export.Kind != gocronometer.ExportTypeBiometrics || len(export.Biometrics) != 1 || len(export.Servings) != 0 {
+		t.Fatalf("expected a biometrics export but found %+v", export)
+	}
+	if export.Biometrics[0].RecordedTime.Location() != location {
+		t.Fatalf("expected the time in %s but found %s", location, export.Biometrics[0].RecordedTime.Location())
+	}
+
+	export, err = gocronometer.Parse(strings.NewReader("Date,Energy (kcal),Completed\n2021-06-01,2000,true\n"), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if export.Kind != gocronometer.ExportTypeDailySummary || len(export.DailyNutrition) != 1 {
+		t.Fatalf("expected a daily summary export but found %+v", export)
+	}
+	if daily := export.DailyNutrition[0]; daily.Day.String() != "2021-06-01" || daily.Totals.EnergyKcal != 2000 || !daily.Completed {
+		t.Fatalf("unexpected day: %+v", daily)
+	}
+
+	if _, err := gocronometer.Parse(strings.NewReader("Name,Value\nA,1\n"), time.UTC); err == nil {
+		t.Fatal("expected an error for an unknown export")
+	}
+}
@@ -0,0 +1,127 @@
+// Package dri provides the Dietary Reference Intakes of the National Academies as report profiles, so nutrient
+// targets can be compared without the user supplying their own numbers:
+//
+//	profile, err := dri.Lookup(35, dri.Female, dri.Standard)
+//	summary := report.Summarize(start, end, servings, biometrics, profile.Targets())
+//	ranges := profile.Check(summary)
+//
+// The minimum of each range is the Recommended Dietary Allowance, or the Adequate Intake where no allowance is
+// established. Sodium has a maximum of its Chronic Disease Risk Reduction intake. The tables cover ages 14 and over,
+// and pregnancy and lactation from 19 to 50. The values are general guidance for healthy people and not medical
+// advice.
+package dri
+
+import (
+	"fmt"
+	"github.com/burke/gocronometer/report"
+)
+
+// Sex is the sex the reference intakes are given for.
+type Sex int
+
+// The following are the sexes the reference intakes are given for.
+const (
+	Male Sex = iota
+	Female
+)
+
+// String returns the name of the sex.
+func (s Sex) String() string {
+	if s == Female {
+		return "female"
+	}
+	return "male"
+}
+
+// Stage is the life stage the reference intakes are given for.
+type Stage int
+
+// The following are the life stages the reference intakes are given for. Pregnant and Lactating are only valid for
+// females.
+const (
+	Standard Stage = iota
+	Pregnant
+	Lactating
+)
+
+// String returns the name of the stage.
+func (s Stage) String() string {
+	switch s {
+	case Pregnant:
+		return "pregnant"
+	case Lactating:
+		return "lactating"
+	default:
+		return "standard"
+	}
+}
+
+// columns are the nutrients of the rows of the tables, by their Cronometer name. The units are those of the nutrients
+// in the servings export, so fluoride is in µg and vitamin D in IU.
+var columns = []string{
+	"Protein", "Fiber", "Vitamin A", "Vitamin C", "Vitamin D", "Vitamin E", "Vitamin K", "B1 (Thiamine)",
+	"B2 (Riboflavin)", "B3 (Niacin)", "B5 (Pantothenic Acid)", "B6 (Pyridoxine)", "Folate", "B12 (Cobalamin)",
+	"Biotin", "Choline", "Calcium", "Chromium", "Copper", "Fluoride", "Iodine", "Iron", "Magnesium", "Manganese",
+	"Molybdenum", "Phosphorus", "Potassium", "Selenium", "Zinc",
+}
+
+// sodiumLimit is the Chronic Disease Risk Reduction intake of sodium in mg for ages 14 and over.
+const sodiumLimit = 2300
+
+// group is a row of the tables for an age range.
+type group struct {
+	minAge int
+	maxAge int // The oldest age of the group, or zero for no limit.
+	values []float64
+}
+
+// tables holds the rows of each sex and stage, in the order of columns.
+var tables = map[Sex]map[Stage][]group{
+	Male: {
+		Standard: {
+			{14, 18, []float64{52, 38, 900, 75, 600, 15, 75, 1.2, 1.3, 16, 5, 1.3, 400, 2.4, 25, 550, 1300, 35, 0.89, 3000, 150, 11, 410, 2.2, 43, 1250, 3000, 55, 11}},
+			{19, 30, []float64{56, 38, 900, 90, 600, 15, 120, 1.2, 1.3, 16, 5, 1.3, 400, 2.4, 30, 550, 1000, 35, 0.9, 4000, 150, 8, 400, 2.3, 45, 700, 3400, 55, 11}},
+			{31, 50, []float64{56, 38, 900, 90, 600, 15, 120, 1.2, 1.3, 16, 5, 1.3, 400, 2.4, 30, 550, 1000, 35, 0.9, 4000, 150, 8, 420, 2.3, 45, 700, 3400, 55, 11}},
+			{51, 70, []float64{56, 30, 900, 90, 600, 15, 120, 1.2, 1.3, 16, 5, 1.7, 400, 2.4, 30, 550, 1000, 30, 0.9, 4000, 150, 8, 420, 2.3, 45, 700, 3400, 55, 11}},
+			{71, 0, []float64{56, 30, 900, 90, 800, 15, 120, 1.2, 1.3, 16, 5, 1.7, 400, 2.4, 30, 550, 1200, 30, 0.9, 4000, 150, 8, 420, 2.3, 45, 700, 3400, 55, 11}},
+		},
+	},
+	Female: {
+		Standard: {
+			{14, 18, []float64{46, 26, 700, 65, 600, 15, 75, 1.0, 1.0, 14, 5, 1.2, 400, 2.4, 25, 400, 1300, 24, 0.89, 3000, 150, 15, 360, 1.6, 43, 1250, 2300, 55, 9}},
+			{19, 30, []float64{46, 25, 700, 75, 600, 15, 90, 1.1, 1.1, 14, 5, 1.3, 400, 2.4, 30, 425, 1000, 25, 0.9, 3000, 150, 18, 310, 1.8, 45, 700, 2600, 55, 8}},
+			{31, 50, []float64{46, 25, 700, 75, 600, 15, 90, 1.1, 1.1, 14, 5, 1.3, 400, 2.4, 30, 425, 1000, 25, 0.9, 3000, 150, 18, 320, 1.8, 45, 700, 2600, 55, 8}},
+			{51, 70, []float64{46, 21, 700, 75, 600, 15, 90, 1.1, 1.1, 14, 5, 1.5, 400, 2.4, 30, 425, 1200, 20, 0.9, 3000, 150, 8, 320, 1.8, 45, 700, 2600, 55, 8}},
+			{71, 0, []float64{46, 21, 700, 75, 800, 15, 90, 1.1, 1.1, 14, 5, 1.5, 400, 2.4, 30, 425, 1200, 20, 0.9, 3000, 150, 8, 320, 1.8, 45, 700, 2600, 55, 8}},
+		},
+		Pregnant: {
+			{19, 30, []float64{71, 28, 770, 85, 600, 15, 90, 1.4, 1.4, 18, 6, 1.9, 600, 2.6, 30, 450, 1000, 30, 1.0, 3000, 220, 27, 350, 2.0, 50, 700, 2900, 60, 11}},
+			{31, 50, []float64{71, 28, 770, 85, 600, 15, 90, 1.4, 1.4, 18, 6, 1.9, 600, 2.6, 30, 450, 1000, 30, 1.0, 3000, 220, 27, 360, 2.0, 50, 700, 2900, 60, 11}},
+		},
+		Lactating: {
+			{19, 30, []float64{71, 29, 1300, 120, 600, 19, 90, 1.4, 1.6, 17, 7, 2.0, 500, 2.8, 35, 550, 1000, 45, 1.3, 3000, 290, 9, 310, 2.6, 50, 700, 2800, 70, 12}},
+			{31, 50, []float64{71, 29, 1300, 120, 600, 19, 90, 1.4, 1.6, 17, 7, 2.0, 500, 2.8, 35, 550, 1000, 45, 1.3, 3000, 290, 9, 320, 2.6, 50, 700, 2800, 70, 12}},
+		},
+	},
+}
+
+// Lookup returns the reference intakes for the age in years, sex and stage as a profile. An error is returned for
+// ages, sexes and stages the tables do not cover.
+func Lookup(age int, sex Sex, stage Stage) (report.Profile, error) {
+	for _, g := range tables[sex][stage] {
+		if age < g.minAge || (g.maxAge != 0 && age > g.maxAge) {
+			continue
+		}
+
+		profile := report.Profile{
+			Name:   fmt.Sprintf("dri-%s-%s-%d", sex, stage, g.minAge),
+			Ranges: map[string]report.Range{"Sodium": {Max: sodiumLimit}},
+		}
+		for i, name := range columns {
+			profile.Ranges[name] = report.Range{Min: g.values[i]}
+		}
+		return profile, nil
+	}
+
+	return report.Profile{}, fmt.Errorf("no reference intakes for a %s aged %d in the %s stage", sex, age, stage)
+}
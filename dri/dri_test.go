@@ -0,0 +1,48 @@
+package dri_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/dri"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	profile, err := dri.Lookup(35, dri.Female, dri.Standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Ranges["Iron"].Min != 18 || profile.Ranges["Magnesium"].Min != 320 || profile.Ranges["Sodium"].Max != 2300 {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+	for name := range profile.Ranges {
+		if _, ok := gocronometer.LookupNutrient(name); !ok {
+			t.Fatalf("unknown nutrient %q", name)
+		}
+	}
+
+	profile, err = dri.Lookup(75, dri.Male, dri.Standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Ranges["Vitamin D"].Min != 800 || profile.Ranges["Calcium"].Min != 1200 {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+
+	profile, err = dri.Lookup(28, dri.Female, dri.Pregnant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Ranges["Iron"].Min != 27 || profile.Ranges["Folate"].Min != 600 {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+
+	for _, invalid := range []struct {
+		age   int
+		sex   dri.Sex
+		stage dri.Stage
+	}{{10, dri.Male, dri.Standard}, {30, dri.Male, dri.Pregnant}, {55, dri.Female, dri.Lactating}} {
+		if _, err := dri.Lookup(invalid.age, invalid.sex, invalid.stage); err == nil {
+			t.Fatalf("expected an error for %+v", invalid)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package gocronometer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DSTPolicy chooses the instant of the local times around a daylight saving transition that do not map to exactly one
+// instant: the times repeated when the clocks fall back, which are ambiguous, and the times skipped when the clocks
+// spring forward, which do not exist but can still be found in exports.
+type DSTPolicy int
+
+const (
+	// DSTBefore applies the UTC offset in effect before the transition. An ambiguous time is its first occurrence
+	// and a skipped time is moved forward by the length of the gap, so 02:30 on a day springing forward from 02:00 to
+	// 03:00 is 03:30, as shown by a clock that was not adjusted.
+	DSTBefore DSTPolicy = iota
+
+	// DSTAfter applies the UTC offset in effect after the transition. An ambiguous time is its second occurrence and
+	// a skipped time is moved back by the length of the gap.
+	DSTAfter
+
+	// DSTReject fails the parse of ambiguous and skipped times with ErrAmbiguousTime.
+	DSTReject
+)
+
+// ErrAmbiguousTime is returned, wrapped, for local times that are ambiguous or skipped by a daylight saving transition
+// when the DSTPolicy is DSTReject.
+var ErrAmbiguousTime = errors.New("ambiguous local time")
+
+// localTime returns the instant of the clock time on the day in the location, resolving the times around a daylight
+// saving transition by the DSTPolicy. A nil receiver uses the defaults.
+func (o *ParseOptions) localTime(day Date, hour int, min int, sec int, location *time.Location) (time.Time, error) {
+	t := time.Date(day.Year, day.Month, day.Day, hour, min, sec, 0, location)
+
+	// The offsets half a day either side of the clock time are those before and after any transition near it, as
+	// transitions are months apart.
+	_, before := t.Add(-12 * time.Hour).Zone()
+	_, after := t.Add(12 * time.Hour).Zone()
+	if before == after {
+		return t, nil
+	}
+
+	// Each offset gives a candidate instant, which is valid when the offset is in effect at that instant.
+	wall := time.Date(day.Year, day.Month, day.Day, hour, min, sec, 0, time.UTC)
+	beforeTime := wall.Add(-time.Duration(before) * time.Second).In(location)
+	afterTime := wall.Add(-time.Duration(after) * time.Second).In(location)
+	_, beforeOffset := beforeTime.Zone()
+	_, afterOffset := afterTime.Zone()
+	beforeValid, afterValid := beforeOffset == before, afterOffset == after
+
+	if beforeValid != afterValid {
+		if beforeValid {
+			return beforeTime, nil
+		}
+		return afterTime, nil
+	}
+
+	var policy DSTPolicy
+	if o != nil {
+		policy = o.DSTPolicy
+	}
+
+	switch policy {
+	case DSTAfter:
+		return afterTime, nil
+	case DSTReject:
+		kind := "ambiguous"
+		if !beforeValid {
+			kind = "skipped"
+		}
+		return time.Time{}, fmt.Errorf("%s %02d:%02d:%02d on %s in %s: %w", kind, hour, min, sec, day, location,
+			ErrAmbiguousTime)
+	default:
+		return beforeTime, nil
+	}
+}
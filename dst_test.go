@@ -0,0 +1,77 @@
+package gocronometer_test
+
+import (
+	"errors"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseServings_DST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2021-03-14 02:30 was skipped and 2021-11-07 01:30 happened twice in New York.
+	raw := "Day,Time,Food Name,Amount\n" +
+		"2021-03-14,02:30,Coffee,1 cup\n" +
+		"2021-11-07,01:30,Tea,1 cup\n" +
+		"2021-11-07,12:00,Soup,1 cup\n"
+
+	cases := []struct {
+		policy  gocronometer.DSTPolicy
+		skipped string
+		repeat  string
+	}{
+		{gocronometer.DSTBefore, "2021-03-14T03:30:00-04:00", "2021-11-07T01:30:00-04:00"},
+		{gocronometer.DSTAfter, "2021-03-14T01:30:00-05:00", "2021-11-07T01:30:00-05:00"},
+	}
+
+	for _, c := range cases {
+		servings, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithLocation(newYork),
+			gocronometer.WithDSTPolicy(c.policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s := servings[0].RecordedTime.Format(time.RFC3339); s != c.skipped {
+			t.Fatalf("expected the skipped time to be %s but found %s", c.skipped, s)
+		}
+		if s := servings[1].RecordedTime.Format(time.RFC3339); s != c.repeat {
+			t.Fatalf("expected the repeated time to be %s but found %s", c.repeat, s)
+		}
+		if s := servings[2].RecordedTime.Format(time.RFC3339); s != "2021-11-07T12:00:00-05:00" {
+			t.Fatalf("expected the time after the transition to be unaffected but found %s", s)
+		}
+		if servings[0].Day != (gocronometer.Date{Year: 2021, Month: time.March, Day: 14}) {
+			t.Fatalf("expected the day to be unaffected but found %s", servings[0].Day)
+		}
+	}
+
+	_, err = gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithLocation(newYork),
+		gocronometer.WithDSTPolicy(gocronometer.DSTReject))
+	var rowErr *gocronometer.RowError
+	if !errors.Is(err, gocronometer.ErrAmbiguousTime) || !errors.As(err, &rowErr) || rowErr.Row != 2 {
+		t.Fatalf("expected the skipped time to be rejected but found %v", err)
+	}
+}
+
+func TestParseServings_DSTDefaultTime(t *testing.T) {
+	// Santiago sprang forward from midnight to 01:00 on 2021-09-05, skipping the default time of midnight.
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := "Day,Food Name,Amount\n2021-09-05,Coffee,1 cup\n"
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithLocation(santiago))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := servings[0].RecordedTime.Format(time.RFC3339); s != "2021-09-05T01:00:00-03:00" {
+		t.Fatalf("expected the skipped midnight to be moved forward but found %s", s)
+	}
+}
@@ -0,0 +1,133 @@
+package gocronometer
+
+import (
+	"math"
+)
+
+// EqualOptions configures how records are compared by the Equal methods. Zero values compare floats exactly.
+type EqualOptions struct {
+	// AbsoluteTolerance is the largest difference at which two floats are considered equal, for example 0.005 to
+	// ignore differences in rounding to two decimal places.
+	AbsoluteTolerance float64
+
+	// RelativeTolerance is the largest difference at which two floats are considered equal as a fraction of the
+	// larger of their magnitudes, for example 0.01 to accept a difference of 1%. Floats are equal when they are within
+	// either tolerance.
+	RelativeTolerance float64
+}
+
+// EqualOption configures the EqualOptions used by the Equal methods.
+type EqualOption func(*EqualOptions)
+
+// WithAbsoluteTolerance sets EqualOptions.AbsoluteTolerance.
+func WithAbsoluteTolerance(tolerance float64) EqualOption {
+	return func(o *EqualOptions) {
+		o.AbsoluteTolerance = tolerance
+	}
+}
+
+// WithRelativeTolerance sets EqualOptions.RelativeTolerance.
+func WithRelativeTolerance(tolerance float64) EqualOption {
+	return func(o *EqualOptions) {
+		o.RelativeTolerance = tolerance
+	}
+}
+
+// newEqualOptions builds the EqualOptions from the options provided.
+func newEqualOptions(options ...EqualOption) *EqualOptions {
+	opts := &EqualOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
+
+// floatEqual returns true if the floats are equal within the tolerances.
+func (opts *EqualOptions) floatEqual(a float64, b float64) bool {
+	if a == b {
+		return true
+	}
+
+	diff := math.Abs(a - b)
+	return diff <= opts.AbsoluteTolerance || diff <= opts.RelativeTolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// Equal returns true if the servings are equal. Recorded times are equal when they are the same instant regardless
+// of their location, the nutrients and quantity are compared with the tolerances of the options, and Raw is not
+// compared.
+func (s ServingRecord) Equal(o ServingRecord, options ...EqualOption) bool {
+	return newEqualOptions(options...).servingEqual(s, o)
+}
+
+// servingEqual implements ServingRecord.Equal.
+func (opts *EqualOptions) servingEqual(s ServingRecord, o ServingRecord) bool {
+	if !s.RecordedTime.Equal(o.RecordedTime) || s.Day != o.Day || s.HasTime != o.HasTime || s.Group != o.Group ||
+		s.FoodName != o.FoodName || s.Source != o.Source || s.FoodID != o.FoodID ||
+		s.QuantityUnits != o.QuantityUnits || s.Category != o.Category ||
+		!opts.floatEqual(s.QuantityValue, o.QuantityValue) {
+		return false
+	}
+
+	for _, n := range nutrients {
+		if !opts.floatEqual(n.Value(s), n.Value(o)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal returns true if the exercises are equal. They are compared in the same way as ServingRecord.Equal.
+func (e ExerciseRecord) Equal(o ExerciseRecord, options ...EqualOption) bool {
+	return newEqualOptions(options...).exerciseEqual(e, o)
+}
+
+// exerciseEqual implements ExerciseRecord.Equal.
+func (opts *EqualOptions) exerciseEqual(e ExerciseRecord, o ExerciseRecord) bool {
+	return e.RecordedTime.Equal(o.RecordedTime) && e.Day == o.Day && e.HasTime == o.HasTime &&
+		e.Exercise == o.Exercise && opts.floatEqual(e.Minutes, o.Minutes) &&
+		opts.floatEqual(e.CaloriesBurned, o.CaloriesBurned)
+}
+
+// Equal returns true if the biometrics are equal. They are compared in the same way as ServingRecord.Equal.
+func (b BiometricRecord) Equal(o BiometricRecord, options ...EqualOption) bool {
+	return newEqualOptions(options...).biometricEqual(b, o)
+}
+
+// biometricEqual implements BiometricRecord.Equal.
+func (opts *EqualOptions) biometricEqual(b BiometricRecord, o BiometricRecord) bool {
+	return b.RecordedTime.Equal(o.RecordedTime) && b.Day == o.Day && b.HasTime == o.HasTime &&
+		b.Metric == o.Metric && b.Unit == o.Unit && opts.floatEqual(b.Amount, o.Amount)
+}
+
+// Equal returns true if the collections hold equal servings in the same order. Collections in different orders can be
+// compared after sorting them with SortByTime.
+func (s ServingRecords) Equal(o ServingRecords, options ...EqualOption) bool {
+	return recordsEqual(s, o, newEqualOptions(options...).servingEqual)
+}
+
+// Equal returns true if the collections hold equal exercises in the same order.
+func (e ExerciseRecords) Equal(o ExerciseRecords, options ...EqualOption) bool {
+	return recordsEqual(e, o, newEqualOptions(options...).exerciseEqual)
+}
+
+// Equal returns true if the collections hold equal biometrics in the same order.
+func (b BiometricRecords) Equal(o BiometricRecords, options ...EqualOption) bool {
+	return recordsEqual(b, o, newEqualOptions(options...).biometricEqual)
+}
+
+// recordsEqual compares the collections element by element.
+func recordsEqual[S ~[]E, E any](a S, b S, equal func(E, E) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestServingRecord_Equal(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)
+	a := gocronometer.ServingRecord{RecordedTime: recorded, FoodName: "Eggs", EnergyKcal: 140, ProteinG: 12.5}
+	b := a
+	b.RecordedTime = recorded.In(newYork)
+	b.Raw = &gocronometer.RawRow{}
+
+	if !a.Equal(b) {
+		t.Fatal("expected servings at the same instant to be equal")
+	}
+
+	b.ProteinG = 12.504
+	if a.Equal(b) {
+		t.Fatal("expected servings with different protein to differ")
+	}
+	if !a.Equal(b, gocronometer.WithAbsoluteTolerance(0.005)) {
+		t.Fatal("expected servings within the absolute tolerance to be equal")
+	}
+
+	b.EnergyKcal = 141
+	if a.Equal(b, gocronometer.WithAbsoluteTolerance(0.005)) {
+		t.Fatal("expected servings outside the absolute tolerance to differ")
+	}
+	if !a.Equal(b, gocronometer.WithRelativeTolerance(0.01)) {
+		t.Fatal("expected servings within the relative tolerance to be equal")
+	}
+
+	b = a
+	b.FoodName = "Rice"
+	if a.Equal(b, gocronometer.WithRelativeTolerance(1)) {
+		t.Fatal("expected servings of different foods to differ")
+	}
+}
+
+func TestExerciseRecord_Equal(t *testing.T) {
+	a := gocronometer.ExerciseRecord{Exercise: "Walking", Minutes: 30, CaloriesBurned: -120}
+	b := a
+	b.CaloriesBurned = -120.4
+
+	if a.Equal(b) || !a.Equal(b, gocronometer.WithAbsoluteTolerance(0.5)) {
+		t.Fatal("unexpected comparison of the calories burned")
+	}
+
+	b.HasTime = true
+	if a.Equal(b, gocronometer.WithAbsoluteTolerance(0.5)) {
+		t.Fatal("expected exercises with and without a time to differ")
+	}
+}
+
+func TestBiometricRecords_Equal(t *testing.T) {
+	a := gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}, {Metric: "Heart Rate", Amount: 62}}
+	b := gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80.01}, {Metric: "Heart Rate", Amount: 62}}
+
+	if a.Equal(b) || !a.Equal(b, gocronometer.WithAbsoluteTolerance(0.05)) {
+		t.Fatal("unexpected comparison of the amounts")
+	}
+	if a.Equal(b[:1], gocronometer.WithAbsoluteTolerance(0.05)) {
+		t.Fatal("expected collections of different lengths to differ")
+	}
+	if a.Equal(gocronometer.BiometricRecords{b[1], b[0]}, gocronometer.WithAbsoluteTolerance(0.05)) {
+		t.Fatal("expected collections in a different order to differ")
+	}
+}
@@ -0,0 +1,67 @@
+package gocronometer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The following errors are returned, wrapped, by the client so callers can handle the failures with errors.Is.
+var (
+	// ErrAuthFailed is returned when Cronometer rejects the credentials of Login or the GWT authentication fails.
+	ErrAuthFailed = errors.New("authentication failed")
+
+	// ErrSessionExpired is returned when Cronometer rejects the session of the client, such as when the session
+	// cookie expires during a long running sync. Exports log in again and retry before returning it.
+	ErrSessionExpired = errors.New("session expired")
+
+	// ErrRateLimited is returned when Cronometer responds with 429 Too Many Requests. The error is a
+	// *RateLimitedError holding the time to wait before retrying.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrExportUnavailable is returned when Cronometer fails to serve an export, such as when the export endpoint is
+	// down or the type of export is not found.
+	ErrExportUnavailable = errors.New("export unavailable")
+)
+
+// RateLimitedError is the error returned when Cronometer responds with 429 Too Many Requests. It matches
+// ErrRateLimited with errors.Is.
+//
+//	var limited *gocronometer.RateLimitedError
+//	if errors.As(err, &limited) {
+//		time.Sleep(limited.RetryAfter)
+//	}
+type RateLimitedError struct {
+	// RetryAfter is the time to wait before retrying, from the Retry-After header. It is zero when the header is
+	// missing or invalid.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s, retry after %s", ErrRateLimited, e.RetryAfter)
+	}
+	return ErrRateLimited.Error()
+}
+
+// Is returns true for ErrRateLimited.
+func (e *RateLimitedError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// newRateLimitedError creates the error of the 429 response, parsing its Retry-After header as either seconds or an
+// HTTP date.
+func newRateLimitedError(resp *http.Response, now time.Time) *RateLimitedError {
+	value := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return &RateLimitedError{RetryAfter: time.Duration(seconds) * time.Second}
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return &RateLimitedError{RetryAfter: date.Sub(now)}
+	}
+
+	return &RateLimitedError{}
+}
@@ -0,0 +1,56 @@
+package gocronometer_test
+
+import (
+	"context"
+	"errors"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// respondingClient returns a client answering every request with the status, headers and body.
+func respondingClient(status int, header http.Header, body string) *gocronometer.Client {
+	client := gocronometer.NewClient(nil)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header, Request: req}
+	})
+	return client
+}
+
+func TestClientErrors(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	client := respondingClient(http.StatusTooManyRequests, http.Header{"Retry-After": {"30"}}, "")
+	_, err := client.ExportServingsParsed(ctx, day, day)
+	var limited *gocronometer.RateLimitedError
+	if !errors.Is(err, gocronometer.ErrRateLimited) || !errors.As(err, &limited) || limited.RetryAfter != 30*time.Second {
+		t.Fatalf("expected to be rate limited for 30s but found %v", err)
+	}
+
+	client = gocronometer.NewClient(nil)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) *http.Response {
+		body := `<input name="anticsrf" value="csrf">`
+		if req.Method == http.MethodPost {
+			body = `{"success":false,"error":"Invalid password"}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}, Request: req}
+	})
+	if err := client.Login(ctx, "user", "wrong"); !errors.Is(err, gocronometer.ErrAuthFailed) {
+		t.Fatalf("expected the authentication to fail but found %v", err)
+	}
+
+	client = gocronometer.NewClient(nil)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader("down")), Header: http.Header{}, Request: req}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`//OK[1,["token"],0,7]`)), Header: http.Header{}, Request: req}
+	})
+	if _, err := client.ExportNotes(ctx, day, day); !errors.Is(err, gocronometer.ErrExportUnavailable) {
+		t.Fatalf("expected the export to be unavailable but found %v", err)
+	}
+}
@@ -0,0 +1,222 @@
+package gocronometer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Exporter writes parsed records to a sink, such as a file or a database. Implementations are interchangeable so the
+// destination of the records can be chosen by configuration.
+type Exporter interface {
+	WriteServings(servings ServingRecords) error
+	WriteExercises(exercises ExerciseRecords) error
+	WriteBiometrics(biometrics BiometricRecords) error
+}
+
+// CSVExporter writes records in the format of the Cronometer exports. Each type of record is written to its own
+// writer, because the exports differ in their columns. Writing a type of record without a writer returns an error.
+// Each call writes the header row, so every call should be given a new writer.
+type CSVExporter struct {
+	Servings   io.Writer
+	Exercises  io.Writer
+	Biometrics io.Writer
+}
+
+// WriteServings writes the servings to Servings.
+func (e *CSVExporter) WriteServings(servings ServingRecords) error {
+	if e.Servings == nil {
+		return fmt.Errorf("no writer for servings")
+	}
+	return WriteServingsCSV(e.Servings, servings)
+}
+
+// WriteExercises writes the exercises to Exercises.
+func (e *CSVExporter) WriteExercises(exercises ExerciseRecords) error {
+	if e.Exercises == nil {
+		return fmt.Errorf("no writer for exercises")
+	}
+	return WriteExerciseCSV(e.Exercises, exercises)
+}
+
+// WriteBiometrics writes the biometrics to Biometrics.
+func (e *CSVExporter) WriteBiometrics(biometrics BiometricRecords) error {
+	if e.Biometrics == nil {
+		return fmt.Errorf("no writer for biometrics")
+	}
+	return WriteBiometricsCSV(e.Biometrics, biometrics)
+}
+
+// JSONExporter writes records as JSON lines to a single writer. Each line is an object holding the type of export
+// the record belongs to in "kind" and the record in "record", so records of every type can share the writer.
+type JSONExporter struct {
+	enc *json.Encoder
+}
+
+// jsonLine is a line written by the JSONExporter.
+type jsonLine struct {
+	Kind   string      `json:"kind"`
+	Record interface{} `json:"record"`
+}
+
+// NewJSONExporter creates an exporter writing JSON lines to w.
+func NewJSONExporter(w io.Writer) *JSONExporter {
+	return &JSONExporter{enc: json.NewEncoder(w)}
+}
+
+// WriteServings writes a line for each serving.
+func (e *JSONExporter) WriteServings(servings ServingRecords) error {
+	for _, s := range servings {
+		if err := e.write(ExportTypeServings, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteExercises writes a line for each exercise.
+func (e *JSONExporter) WriteExercises(exercises ExerciseRecords) error {
+	for _, x := range exercises {
+		if err := e.write(ExportTypeExercises, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteBiometrics writes a line for each biometric.
+func (e *JSONExporter) WriteBiometrics(biometrics BiometricRecords) error {
+	for _, b := range biometrics {
+		if err := e.write(ExportTypeBiometrics, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the line of the record.
+func (e *JSONExporter) write(kind ExportType, record interface{}) error {
+	if err := e.enc.Encode(jsonLine{Kind: kind.String(), Record: record}); err != nil {
+		return fmt.Errorf("writing %s: %w", kind, err)
+	}
+	return nil
+}
+
+// InfluxExporter writes records in the line protocol of InfluxDB, so they can be written to a bucket with the influx
+// CLI or the write API. Each record is a point of the measurement named by its type of export, such as "servings",
+// timestamped with RecordedTime in nanoseconds.
+//
+// Servings are tagged with their group, food name, source and category, and hold the amount, its unit and the value
+// of each nutrient, keyed by the nutrient name. Exercises are tagged with the exercise and hold the minutes and
+// calories burned, and biometrics are tagged with the metric and unit and hold the amount.
+type InfluxExporter struct {
+	w io.Writer
+}
+
+// NewInfluxExporter creates an exporter writing line protocol to w.
+func NewInfluxExporter(w io.Writer) *InfluxExporter {
+	return &InfluxExporter{w: w}
+}
+
+// WriteServings writes a point for each serving.
+func (e *InfluxExporter) WriteServings(servings ServingRecords) error {
+	for _, s := range servings {
+		p := newInfluxPoint(ExportTypeServings).
+			tag("group", s.Group).tag("food", s.FoodName).tag("source", s.Source).tag("category", s.Category).
+			float("amount", s.QuantityValue).string("unit", s.QuantityUnits)
+		for _, n := range nutrients {
+			p.float(n.Name, n.Value(s))
+		}
+		if err := e.write(ExportTypeServings, p, s.RecordedTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteExercises writes a point for each exercise.
+func (e *InfluxExporter) WriteExercises(exercises ExerciseRecords) error {
+	for _, x := range exercises {
+		p := newInfluxPoint(ExportTypeExercises).
+			tag("exercise", x.Exercise).
+			float("minutes", x.Minutes).float("calories_burned", x.CaloriesBurned)
+		if err := e.write(ExportTypeExercises, p, x.RecordedTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteBiometrics writes a point for each biometric.
+func (e *InfluxExporter) WriteBiometrics(biometrics BiometricRecords) error {
+	for _, b := range biometrics {
+		p := newInfluxPoint(ExportTypeBiometrics).
+			tag("metric", b.Metric).tag("unit", b.Unit).
+			float("amount", b.Amount)
+		if err := e.write(ExportTypeBiometrics, p, b.RecordedTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the line of the point with the timestamp.
+func (e *InfluxExporter) write(kind ExportType, p *influxPoint, t time.Time) error {
+	if _, err := io.WriteString(e.w, p.line(t)); err != nil {
+		return fmt.Errorf("writing %s: %w", kind, err)
+	}
+	return nil
+}
+
+// influxPoint builds a line of the line protocol.
+type influxPoint struct {
+	tags   strings.Builder
+	fields strings.Builder
+}
+
+func newInfluxPoint(kind ExportType) *influxPoint {
+	p := &influxPoint{}
+	p.tags.WriteString(influxMeasurementEscaper.Replace(kind.String()))
+	return p
+}
+
+// tag adds the tag, unless the value is empty, which the line protocol does not allow.
+func (p *influxPoint) tag(key, value string) *influxPoint {
+	if value != "" {
+		p.tags.WriteString("," + influxKeyEscaper.Replace(key) + "=" + influxKeyEscaper.Replace(value))
+	}
+	return p
+}
+
+// float adds the float field.
+func (p *influxPoint) float(key string, value float64) *influxPoint {
+	return p.field(key, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// string adds the string field.
+func (p *influxPoint) string(key, value string) *influxPoint {
+	return p.field(key, `"`+influxStringEscaper.Replace(value)+`"`)
+}
+
+func (p *influxPoint) field(key, value string) *influxPoint {
+	if p.fields.Len() > 0 {
+		p.fields.WriteByte(',')
+	}
+	p.fields.WriteString(influxKeyEscaper.Replace(key) + "=" + value)
+	return p
+}
+
+// line returns the line of the point with the timestamp, ending with a newline.
+func (p *influxPoint) line(t time.Time) string {
+	return p.tags.String() + " " + p.fields.String() + " " + strconv.FormatInt(t.UnixNano(), 10) + "\n"
+}
+
+// The following escape the special characters of the line protocol.
+var (
+	influxMeasurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `, "\n", `\n`)
+	influxKeyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, "\n", `\n`)
+	influxStringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
@@ -0,0 +1,114 @@
+package gocronometer_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCSVExporter(t *testing.T) {
+	var exercises, biometrics bytes.Buffer
+	var exporter gocronometer.Exporter = &gocronometer.CSVExporter{Exercises: &exercises, Biometrics: &biometrics}
+
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	err := exporter.WriteExercises(gocronometer.ExerciseRecords{{
+		RecordedTime: time.Date(2021, 6, 1, 18, 0, 0, 0, time.UTC), Day: day, HasTime: true, Exercise: "Walking",
+		Minutes: 30, CaloriesBurned: -120.5,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exporter.WriteBiometrics(gocronometer.BiometricRecords{{
+		RecordedTime: day.Time(time.UTC), Day: day, Metric: "Weight", Unit: "kg", Amount: 80.25,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if exercises.String() != "Day,Time,Exercise,Minutes,Calories Burned\n2021-06-01,18:00,Walking,30,-120.5\n" {
+		t.Fatalf("unexpected exercises: %q", exercises.String())
+	}
+	if biometrics.String() != "Day,Time,Metric,Unit,Amount\n2021-06-01,,Weight,kg,80.25\n" {
+		t.Fatalf("unexpected biometrics: %q", biometrics.String())
+	}
+
+	if err := exporter.WriteServings(gocronometer.ServingRecords{{FoodName: "Eggs"}}); err == nil {
+		t.Fatal("expected an error writing servings without a writer")
+	}
+}
+
+func TestJSONExporter(t *testing.T) {
+	var buf bytes.Buffer
+	var exporter gocronometer.Exporter = gocronometer.NewJSONExporter(&buf)
+
+	if err := exporter.WriteServings(gocronometer.ServingRecords{{FoodName: "Eggs"}, {FoodName: "Rice"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := exporter.WriteBiometrics(gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var kinds []string
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line struct {
+			Kind   string
+			Record map[string]interface{}
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatal(err)
+		}
+		kinds = append(kinds, line.Kind)
+	}
+
+	if len(kinds) != 3 || kinds[0] != "servings" || kinds[2] != "biometrics" {
+		t.Fatalf("unexpected lines: %v", kinds)
+	}
+}
+
+func TestInfluxExporter(t *testing.T) {
+	var buf bytes.Buffer
+	var exporter gocronometer.Exporter = gocronometer.NewInfluxExporter(&buf)
+
+	recorded := time.Date(2021, 6, 1, 18, 0, 0, 0, time.UTC)
+	err := exporter.WriteExercises(gocronometer.ExerciseRecords{{RecordedTime: recorded, Exercise: "Walking, Brisk", Minutes: 30, CaloriesBurned: -120.5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exporter.WriteBiometrics(gocronometer.BiometricRecords{{RecordedTime: recorded, Metric: "Blood Glucose", Amount: 95}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exporter.WriteServings(gocronometer.ServingRecords{{
+		RecordedTime: recorded, Group: "Breakfast", FoodName: `Eggs "Sunny"=Side`, QuantityValue: 2, QuantityUnits: `1 "large"`,
+		ProteinG: 12.6, B12Ug: 0.9,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines but found %q", buf.String())
+	}
+	if lines[0] != `exercises,exercise=Walking\,\ Brisk minutes=30,calories_burned=-120.5 1622570400000000000` {
+		t.Fatalf("unexpected exercise line: %s", lines[0])
+	}
+	// Empty tags are omitted.
+	if lines[1] != `biometrics,metric=Blood\ Glucose amount=95 1622570400000000000` {
+		t.Fatalf("unexpected biometric line: %s", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], `servings,group=Breakfast,food=Eggs\ "Sunny"\=Side amount=2,unit="1 \"large\"",Energy=0,`) {
+		t.Fatalf("unexpected serving line: %s", lines[2])
+	}
+	if !strings.Contains(lines[2], ",Protein=12.6,") || !strings.Contains(lines[2], `,B12\ (Cobalamin)=0.9,`) {
+		t.Fatalf("expected the nutrients in the serving line: %s", lines[2])
+	}
+	if !strings.HasSuffix(lines[2], " 1622570400000000000") {
+		t.Fatalf("unexpected timestamp of the serving line: %s", lines[2])
+	}
+}
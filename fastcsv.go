@@ -0,0 +1,233 @@
+package gocronometer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// rowReader reads the rows of a CSV export. It is implemented by csv.Reader and fastCSVReader.
+type rowReader interface {
+	Read() ([]string, error)
+}
+
+// newRowReader returns the reader of the rows of the export, the fastCSVReader when ParseOptions.FastCSV is set. In
+// lenient mode rows may have any number of fields, otherwise every row must have as many fields as the first.
+func (o *ParseOptions) newRowReader(r io.Reader, lenient bool) rowReader {
+	fieldsPerRecord := 0
+	if lenient {
+		fieldsPerRecord = -1
+	}
+
+	// The row is reused between reads when parsed sequentially, so it is copied when it is kept.
+	reuseRecord := o.Workers <= 1
+
+	if o.FastCSV {
+		return &fastCSVReader{r: bufio.NewReader(r), fieldsPerRecord: fieldsPerRecord, reuseRecord: reuseRecord}
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = fieldsPerRecord
+	cr.ReuseRecord = reuseRecord
+	return cr
+}
+
+// fastCSVReader reads CSV exports by splitting their lines on commas, skipping the byte by byte scanning of
+// encoding/csv. Cronometer only quotes the fields containing a comma or a quote, such as the names of some foods, and
+// fields never span lines in practice. Records that are not a single line of well formed fields are read by a
+// csv.Reader instead, giving the same records and errors as encoding/csv with its default settings.
+type fastCSVReader struct {
+	r               *bufio.Reader
+	fieldsPerRecord int
+	reuseRecord     bool
+
+	line   int
+	record []string
+	buf    []byte
+}
+
+// Read reads the next record as csv.Reader.Read.
+func (f *fastCSVReader) Read() ([]string, error) {
+	for {
+		line, err := f.readLine()
+		if len(line) == 0 || err != nil {
+			return nil, err
+		}
+		startLine := f.line
+
+		// Empty lines are skipped as by encoding/csv.
+		trimmed := trimEOL(line)
+		if len(trimmed) == 0 {
+			continue
+		}
+
+		record := f.record[:0]
+		if !f.reuseRecord {
+			record = make([]string, 0, len(f.record))
+		}
+		record, ok := splitLine(string(trimmed), record)
+		if !ok {
+			record, err := f.readQuoted(line, startLine)
+			if err != nil {
+				return record, err
+			}
+			return f.checkFields(record, startLine)
+		}
+		f.record = record
+
+		return f.checkFields(record, startLine)
+	}
+}
+
+// splitLine appends the fields of the line to record. False is returned for lines that are not a complete record
+// of well formed fields, such as a quoted field continuing on the next line or a stray quote.
+func splitLine(s string, record []string) ([]string, bool) {
+	for {
+		if !strings.HasPrefix(s, `"`) {
+			i := strings.IndexByte(s, ',')
+			field := s
+			if i >= 0 {
+				field = s[:i]
+			}
+			if strings.IndexByte(field, '"') >= 0 {
+				return record, false
+			}
+			record = append(record, field)
+			if i < 0 {
+				return record, true
+			}
+			s = s[i+1:]
+			continue
+		}
+
+		// A quoted field ends at a quote not followed by another, which escapes a quote.
+		field, escaped := "", false
+		s = s[1:]
+		for {
+			i := strings.IndexByte(s, '"')
+			if i < 0 {
+				return record, false
+			}
+			if i+1 < len(s) && s[i+1] == '"' {
+				field += s[:i+1]
+				s = s[i+2:]
+				escaped = true
+				continue
+			}
+			if escaped {
+				field += s[:i]
+			} else {
+				field = s[:i]
+			}
+			s = s[i+1:]
+			break
+		}
+		if strings.ContainsRune(field, '\r') {
+			return record, false
+		}
+		record = append(record, field)
+
+		if s == "" {
+			return record, true
+		}
+		if s[0] != ',' {
+			return record, false
+		}
+		s = s[1:]
+	}
+}
+
+// readLine reads the next line, including its line ending. The line is only valid until the next read. The last line
+// of the export is returned without an error even when it has no line ending.
+func (f *fastCSVReader) readLine() ([]byte, error) {
+	line, err := f.r.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		f.buf = append(f.buf[:0], line...)
+		for err == bufio.ErrBufferFull {
+			line, err = f.r.ReadSlice('\n')
+			f.buf = append(f.buf, line...)
+		}
+		line = f.buf
+	}
+
+	if len(line) > 0 {
+		f.line++
+		if err == io.EOF {
+			err = nil
+		}
+	}
+	return line, err
+}
+
+// readQuoted reads the record starting with the line, which contains a quote, with a csv.Reader. Lines are added to the
+// record while a quoted field continues past the end of the line.
+func (f *fastCSVReader) readQuoted(line []byte, startLine int) ([]string, error) {
+	chunk := append([]byte(nil), line...)
+	for inQuotedField(chunk) {
+		next, err := f.readLine()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if len(next) == 0 {
+			break
+		}
+		chunk = append(chunk, next...)
+	}
+
+	cr := csv.NewReader(bytes.NewReader(chunk))
+	cr.FieldsPerRecord = -1
+	record, err := cr.Read()
+
+	// Reporting the lines of the export rather than those of the chunk.
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		parseErr.StartLine += startLine - 1
+		parseErr.Line += startLine - 1
+	}
+	return record, err
+}
+
+// checkFields checks the number of fields of the record starting on line startLine as csv.Reader.FieldsPerRecord.
+func (f *fastCSVReader) checkFields(record []string, startLine int) ([]string, error) {
+	if f.fieldsPerRecord == 0 {
+		f.fieldsPerRecord = len(record)
+	} else if f.fieldsPerRecord > 0 && len(record) != f.fieldsPerRecord {
+		return record, &csv.ParseError{StartLine: startLine, Line: startLine, Column: 1, Err: csv.ErrFieldCount}
+	}
+	return record, nil
+}
+
+// inQuotedField returns whether the CSV data ends within a quoted field, which continues on the next line. Quotes are
+// only opening a field at its start, so the stray quotes of an unquoted field are left to the csv.Reader to report.
+func inQuotedField(data []byte) bool {
+	inQuotes := false
+	fieldStart := true
+	closed := false
+	for _, c := range data {
+		switch {
+		case inQuotes:
+			if c == '"' {
+				inQuotes, closed = false, true
+			}
+			continue
+		case c == '"' && (fieldStart || closed):
+			// A quote following the closing quote is an escaped quote, reopening the field.
+			inQuotes = true
+		case c == ',' || c == '\n':
+			fieldStart = true
+			closed = false
+			continue
+		}
+		fieldStart, closed = false, false
+	}
+	return inQuotes
+}
+
+// trimEOL trims the line ending of the line.
+func trimEOL(line []byte) []byte {
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r"))
+}
@@ -0,0 +1,86 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/burke/gocronometer"
+)
+
+func TestParseNotes_FastCSV(t *testing.T) {
+	header := "Day,Group,Note\n"
+	exports := map[string]string{
+		"plain":              header + "2021-06-01,Breakfast,slept well\n2021-06-02,Lunch,tired\n",
+		"crlf":               "Day,Group,Note\r\n2021-06-01,Breakfast,slept well\r\n2021-06-02,Lunch,tired\r\n",
+		"no final newline":   header + "2021-06-01,Breakfast,slept well",
+		"empty lines":        header + "\n2021-06-01,Breakfast,slept well\n\n\n2021-06-02,Lunch,tired\n",
+		"empty fields":       header + "2021-06-01,,\n",
+		"quoted comma":       header + "2021-06-01,Breakfast,\"eggs, toast\"\n2021-06-02,Lunch,tired\n",
+		"escaped quotes":     header + "2021-06-01,Breakfast,\"the \"\"good\"\" eggs\"\n2021-06-02,Lunch,tired\n",
+		"quoted newline":     header + "2021-06-01,Breakfast,\"first line\nsecond, line\"\n2021-06-02,Lunch,tired\n",
+		"quoted crlf":        header + "2021-06-01,Breakfast,\"first line\r\nsecond line\"\r\n2021-06-02,Lunch,tired\r\n",
+		"bare quote":         header + "2021-06-01,Breakfast,6\" sub\n2021-06-02,Lunch,tired\n",
+		"text after quote":   header + "2021-06-01,\"Breakfast\"s,tired\n2021-06-02,Lunch,tired\n",
+		"quoted cr":          header + "2021-06-01,\"Break\rfast\",tired\n",
+		"unterminated":       header + "2021-06-01,Breakfast,\"never closed\n2021-06-02,Lunch,tired\n",
+		"field count":        header + "2021-06-01,Breakfast\n2021-06-02,Lunch,tired\n",
+		"quoted field count": header + "2021-06-01,\"Breakfast\"\n2021-06-02,Lunch,tired\n",
+		"long line":          header + "2021-06-01,Breakfast," + strings.Repeat("a", 10000) + "\n",
+		"long quoted line":   header + "2021-06-01,Breakfast,\"" + strings.Repeat("a,", 5000) + "\"\n",
+	}
+
+	for name, export := range exports {
+		for _, lenient := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/lenient=%t", name, lenient), func(t *testing.T) {
+				options := []gocronometer.ParseOption{gocronometer.WithKeepRaw()}
+				if lenient {
+					options = append(options, gocronometer.WithLenient())
+				}
+
+				expected, expectedErr := gocronometer.ParseNotes(strings.NewReader(export), options...)
+				notes, err := gocronometer.ParseNotes(strings.NewReader(export), append(options, gocronometer.WithFastCSV())...)
+
+				if fmt.Sprint(err) != fmt.Sprint(expectedErr) {
+					t.Fatalf("expected the error %v but found %v", expectedErr, err)
+				}
+				if !reflect.DeepEqual(notes, expected) {
+					t.Fatalf("expected the notes %+v but found %+v", expected, notes)
+				}
+			})
+		}
+	}
+}
+
+func TestParseServings_FastCSV(t *testing.T) {
+	export := benchmarkServings(t)
+
+	expected, err := gocronometer.ParseServings(bytes.NewReader(export))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	servings, err := gocronometer.ParseServings(bytes.NewReader(export), gocronometer.WithFastCSV(), gocronometer.WithWorkers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !servings.Equal(expected) {
+		t.Fatalf("expected the servings read by the fast reader to match")
+	}
+}
+
+func BenchmarkParseServings_FastCSV(b *testing.B) {
+	export := benchmarkServings(b)
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := gocronometer.ParseServings(bytes.NewReader(export), gocronometer.WithFastCSV()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
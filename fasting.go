@@ -0,0 +1,77 @@
+package gocronometer
+
+import (
+	"sort"
+	"time"
+)
+
+// FastingOptions configures EatingWindows. The zero value counts every serving with a time.
+type FastingOptions struct {
+	// IgnoreZeroCalorie ignores servings with no more than ZeroCalorieKcal of energy, such as black coffee or water,
+	// which do not break a fast.
+	IgnoreZeroCalorie bool
+
+	// ZeroCalorieKcal is the most energy a serving may have to be ignored by IgnoreZeroCalorie.
+	ZeroCalorieKcal float64
+}
+
+// EatingWindow is the period of a day from its first to its last serving.
+type EatingWindow struct {
+	Day   Date
+	First time.Time
+	Last  time.Time
+
+	// Servings is the number of servings counted in the window.
+	Servings int
+
+	// Fast is the time from the last serving of the previous day to the first serving of the day. It is zero when
+	// HasFast is false.
+	Fast time.Duration
+
+	// HasFast is false when the previous day has no window, as the fast cannot be known.
+	HasFast bool
+}
+
+// Duration returns the time from the first to the last serving of the window.
+func (w EatingWindow) Duration() time.Duration {
+	return w.Last.Sub(w.First)
+}
+
+// EatingWindows infers the eating window of each day from the times of its servings, sorted by day, for
+// intermittent fasting without the fasting timer of Cronometer. Servings without a time are ignored, as the default
+// time they are given says nothing of when they were eaten, and days without a serving counted have no window.
+func (s ServingRecords) EatingWindows(opts FastingOptions) []EatingWindow {
+	windows := make(map[Date]*EatingWindow)
+	for _, serving := range s {
+		if !serving.HasTime || (opts.IgnoreZeroCalorie && serving.EnergyKcal <= opts.ZeroCalorieKcal) {
+			continue
+		}
+
+		w, ok := windows[serving.Day]
+		if !ok {
+			w = &EatingWindow{Day: serving.Day, First: serving.RecordedTime, Last: serving.RecordedTime}
+			windows[serving.Day] = w
+		}
+		if serving.RecordedTime.Before(w.First) {
+			w.First = serving.RecordedTime
+		}
+		if serving.RecordedTime.After(w.Last) {
+			w.Last = serving.RecordedTime
+		}
+		w.Servings++
+	}
+
+	result := make([]EatingWindow, 0, len(windows))
+	for _, w := range windows {
+		if previous, ok := windows[w.Day.AddDays(-1)]; ok {
+			w.Fast = w.First.Sub(previous.Last)
+			w.HasFast = true
+		}
+		result = append(result, *w)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Day.Before(result[j].Day)
+	})
+
+	return result
+}
@@ -0,0 +1,38 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestEatingWindows(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	at := func(d gocronometer.Date, hour int) time.Time {
+		return time.Date(d.Year, d.Month, d.Day, hour, 0, 0, 0, time.UTC)
+	}
+	servings := gocronometer.ServingRecords{
+		{Day: day, RecordedTime: at(day, 19), HasTime: true, EnergyKcal: 600},
+		{Day: day, RecordedTime: at(day, 12), HasTime: true, EnergyKcal: 500},
+		{Day: day, RecordedTime: at(day, 0), EnergyKcal: 100},
+		{Day: day.AddDays(1), RecordedTime: at(day.AddDays(1), 7), HasTime: true, EnergyKcal: 2},
+		{Day: day.AddDays(1), RecordedTime: at(day.AddDays(1), 11), HasTime: true, EnergyKcal: 700},
+		{Day: day.AddDays(1), RecordedTime: at(day.AddDays(1), 18), HasTime: true, EnergyKcal: 800},
+	}
+
+	windows := servings.EatingWindows(gocronometer.FastingOptions{})
+	if len(windows) != 2 {
+		t.Fatalf("expected 2 windows but found %+v", windows)
+	}
+	if windows[0].Duration() != 7*time.Hour || windows[0].Servings != 2 || windows[0].HasFast {
+		t.Fatalf("unexpected first window: %+v", windows[0])
+	}
+	if windows[1].Duration() != 11*time.Hour || !windows[1].HasFast || windows[1].Fast != 12*time.Hour {
+		t.Fatalf("unexpected second window: %+v", windows[1])
+	}
+
+	windows = servings.EatingWindows(gocronometer.FastingOptions{IgnoreZeroCalorie: true, ZeroCalorieKcal: 5})
+	if windows[1].Duration() != 7*time.Hour || windows[1].Fast != 16*time.Hour || windows[1].Servings != 2 {
+		t.Fatalf("unexpected second window ignoring zero calorie servings: %+v", windows[1])
+	}
+}
@@ -0,0 +1,330 @@
+// Package fdc matches the foods of Cronometer servings to the foods of USDA FoodData Central (FDC), so the servings
+// can be enriched with nutrients the Cronometer export omits. Foods are searched with the FDC API, which requires an
+// API key from https://fdc.nal.usda.gov/api-key-signup.html, or in a local snapshot of the food.csv file of the FDC
+// downloads.
+package fdc
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DefaultBaseURL is the base URL of the FDC API.
+const DefaultBaseURL = "https://api.nal.usda.gov/fdc/v1"
+
+// DefaultMinScore is the default Matcher.MinScore.
+const DefaultMinScore = 0.5
+
+// Food is a food of FoodData Central.
+type Food struct {
+	FDCID       int    `json:"fdcId"`
+	Description string `json:"description"`
+	DataType    string `json:"dataType"`
+	BrandOwner  string `json:"brandOwner,omitempty"`
+
+	// Nutrients are the nutrients of 100 g of the food. They are only returned by the API, not by a Snapshot.
+	Nutrients []Nutrient `json:"foodNutrients,omitempty"`
+}
+
+// Nutrient is the amount of a nutrient in 100 g of a food.
+type Nutrient struct {
+	ID     int     `json:"nutrientId"`
+	Name   string  `json:"nutrientName"`
+	Unit   string  `json:"unitName"`
+	Amount float64 `json:"value"`
+}
+
+// Nutrient returns the nutrient of the food with the FDC nutrient ID, such as 1110 for vitamin D (D2 + D3).
+func (f Food) Nutrient(id int) (Nutrient, bool) {
+	for _, n := range f.Nutrients {
+		if n.ID == id {
+			return n, true
+		}
+	}
+
+	return Nutrient{}, false
+}
+
+// Searcher searches FoodData Central for the foods matching a query. It is implemented by Client and Snapshot.
+type Searcher interface {
+	Search(ctx context.Context, query string) ([]Food, error)
+}
+
+// ClientOptions configures the Client. Zero values revert to the defaults.
+type ClientOptions struct {
+	// BaseURL is the base URL of the API. Defaults to DefaultBaseURL.
+	BaseURL string
+
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Client searches foods with the FDC API.
+type Client struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the FDC API authenticated with the API key. A nil opts uses the defaults.
+func NewClient(apiKey string, opts *ClientOptions) *Client {
+	if opts == nil {
+		opts = &ClientOptions{}
+	}
+
+	c := &Client{apiKey: apiKey, baseURL: opts.BaseURL, httpClient: opts.HTTPClient}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+
+	return c
+}
+
+// Search searches the foods matching the query with the foods/search endpoint, returning the first page of results
+// with their nutrients.
+func (c *Client) Search(ctx context.Context, query string) ([]Food, error) {
+	q := url.Values{"api_key": {c.apiKey}, "query": {query}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/foods/search?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating search request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The error of the client includes the URL, which would leak the API key.
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("searching foods: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("searching foods: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		Foods []Food `json:"foods"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding search results: %w", err)
+	}
+
+	return result.Foods, nil
+}
+
+// Snapshot searches the foods of a local copy of FoodData Central, without nutrients.
+type Snapshot struct {
+	foods  []Food
+	tokens [][]string
+}
+
+// LoadSnapshot reads the food.csv file of the FoodData Central downloads, with the fdc_id, data_type and description
+// columns.
+func LoadSnapshot(r io.Reader) (*Snapshot, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	headers, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot headers: %w", err)
+	}
+	columns := make(map[string]int, len(headers))
+	for i, h := range headers {
+		columns[h] = i
+	}
+	for _, required := range []string{"fdc_id", "data_type", "description"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("snapshot is missing the %s column", required)
+		}
+	}
+
+	s := &Snapshot{}
+	for line := 2; ; line++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading snapshot: %w", err)
+		}
+		if len(row) != len(headers) {
+			return nil, fmt.Errorf("line %d of the snapshot has %d fields instead of %d", line, len(row), len(headers))
+		}
+
+		id, err := strconv.Atoi(row[columns["fdc_id"]])
+		if err != nil {
+			return nil, fmt.Errorf("parsing the fdc_id on line %d of the snapshot: %w", line, err)
+		}
+		food := Food{FDCID: id, DataType: row[columns["data_type"]], Description: row[columns["description"]]}
+		s.foods = append(s.foods, food)
+		s.tokens = append(s.tokens, tokens(gocronometer.NormalizeFoodName(food.Description)))
+	}
+
+	return s, nil
+}
+
+// Search returns the foods of the snapshot sharing a word with the query, the most similar first. The names are
+// compared as by Matcher.Match.
+func (s *Snapshot) Search(ctx context.Context, query string) ([]Food, error) {
+	q := tokens(gocronometer.NormalizeFoodName(query))
+
+	type scored struct {
+		food  Food
+		score float64
+	}
+	matches := make([]scored, 0)
+	for i, t := range s.tokens {
+		if score := similarity(q, t); score > 0 {
+			matches = append(matches, scored{food: s.foods[i], score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	foods := make([]Food, 0, len(matches))
+	for _, m := range matches {
+		foods = append(foods, m.food)
+	}
+	return foods, nil
+}
+
+// Match is the FDC food matched to a Cronometer food name.
+type Match struct {
+	Food Food
+
+	// Score is the similarity of the names, from 0 to 1.
+	Score float64
+}
+
+// Matcher matches Cronometer food names to FDC foods, caching the match of every name. It is not safe for concurrent
+// use.
+type Matcher struct {
+	// Searcher finds the candidate foods.
+	Searcher Searcher
+
+	// MinScore is the lowest similarity accepted as a match. Defaults to DefaultMinScore.
+	MinScore float64
+
+	matches map[string]*Match
+}
+
+// NewMatcher returns a Matcher of the foods found by the searcher.
+func NewMatcher(searcher Searcher) *Matcher {
+	return &Matcher{Searcher: searcher}
+}
+
+// Match returns the candidate food most similar to the food name, or false when none reaches MinScore. Names are
+// compared by the words of their gocronometer.NormalizeFoodName, so brands and preparation qualifiers are ignored.
+func (m *Matcher) Match(ctx context.Context, foodName string) (Match, bool, error) {
+	key := gocronometer.NormalizeFoodName(foodName)
+	if match, ok := m.matches[key]; ok {
+		if match == nil {
+			return Match{}, false, nil
+		}
+		return *match, true, nil
+	}
+
+	candidates, err := m.Searcher.Search(ctx, key)
+	if err != nil {
+		return Match{}, false, err
+	}
+
+	minScore := m.MinScore
+	if minScore == 0 {
+		minScore = DefaultMinScore
+	}
+
+	var best *Match
+	name := tokens(key)
+	for _, food := range candidates {
+		score := similarity(name, tokens(gocronometer.NormalizeFoodName(food.Description)))
+		if score >= minScore && (best == nil || score > best.Score) {
+			best = &Match{Food: food, Score: score}
+		}
+	}
+
+	if m.matches == nil {
+		m.matches = make(map[string]*Match)
+	}
+	m.matches[key] = best
+
+	if best == nil {
+		return Match{}, false, nil
+	}
+	return *best, true, nil
+}
+
+// MatchServings matches the food of every serving, returning the matches by food name. Foods without a match are
+// omitted.
+func (m *Matcher) MatchServings(ctx context.Context, servings gocronometer.ServingRecords) (map[string]Match, error) {
+	matches := make(map[string]Match)
+	for _, s := range servings {
+		if _, ok := matches[s.FoodName]; ok {
+			continue
+		}
+
+		match, ok, err := m.Match(ctx, s.FoodName)
+		if err != nil {
+			return nil, fmt.Errorf("matching %q: %w", s.FoodName, err)
+		}
+		if ok {
+			matches[s.FoodName] = match
+		}
+	}
+
+	return matches, nil
+}
+
+// tokens returns the distinct lower case words of the name.
+func tokens(name string) []string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(words))
+	distinct := make([]string, 0, len(words))
+	for _, w := range words {
+		if !seen[w] {
+			seen[w] = true
+			distinct = append(distinct, w)
+		}
+	}
+	return distinct
+}
+
+// similarity is the Dice coefficient of the words a and b, from 0 when they share no word to 1 when they are the
+// same.
+func similarity(a []string, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	inA := make(map[string]bool, len(a))
+	for _, w := range a {
+		inA[w] = true
+	}
+	shared := 0
+	for _, w := range b {
+		if inA[w] {
+			shared++
+		}
+	}
+
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
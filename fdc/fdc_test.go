@@ -0,0 +1,142 @@
+package fdc_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/fdc"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const foodCSV = `"fdc_id","data_type","description","food_category_id","publication_date"
+"171287","sr_legacy_food","Egg, whole, raw, fresh","1","2019-04-01"
+"173424","sr_legacy_food","Egg, whole, cooked, scrambled","1","2019-04-01"
+"171705","sr_legacy_food","Blueberries, raw","9","2019-04-01"
+"170567","sr_legacy_food","Nuts, almonds","12","2019-04-01"
+`
+
+const searchResponse = `{
+	"totalHits": 2,
+	"foods": [
+		{"fdcId": 171705, "description": "Blueberries, raw", "dataType": "SR Legacy", "foodNutrients": [
+			{"nutrientId": 1003, "nutrientName": "Protein", "unitName": "G", "value": 0.74},
+			{"nutrientId": 1110, "nutrientName": "Vitamin D (D2 + D3), International Units", "unitName": "IU", "value": 0}
+		]},
+		{"fdcId": 2345678, "description": "Blueberry Muffins", "dataType": "Branded", "brandOwner": "Bakery Co."}
+	]
+}`
+
+func TestClient_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/foods/search" || r.URL.Query().Get("api_key") != "key" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if query := r.URL.Query().Get("query"); query != "blueberry" {
+			t.Errorf("expected the query blueberry but found %q", query)
+		}
+		w.Write([]byte(searchResponse))
+	}))
+	defer server.Close()
+
+	client := fdc.NewClient("key", &fdc.ClientOptions{BaseURL: server.URL})
+	foods, err := client.Search(context.Background(), "blueberry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(foods) != 2 || foods[0].FDCID != 171705 || foods[1].BrandOwner != "Bakery Co." {
+		t.Fatalf("unexpected foods: %+v", foods)
+	}
+	protein, ok := foods[0].Nutrient(1003)
+	if !ok || protein.Amount != 0.74 || protein.Unit != "G" {
+		t.Fatalf("unexpected protein: %+v", protein)
+	}
+
+	_, err = fdc.NewClient("wrong", &fdc.ClientOptions{BaseURL: server.URL}).Search(context.Background(), "blueberry")
+	if err == nil {
+		t.Fatal("expected an error for a rejected request")
+	}
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	snapshot, err := fdc.LoadSnapshot(strings.NewReader(foodCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foods, err := snapshot.Search(context.Background(), "Eggs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(foods) != 2 || foods[0].FDCID != 171287 || foods[1].FDCID != 173424 {
+		t.Fatalf("unexpected foods: %+v", foods)
+	}
+
+	if _, err := fdc.LoadSnapshot(strings.NewReader("fdc_id,description\n1,Egg\n")); err == nil {
+		t.Fatal("expected an error for a snapshot without the data_type column")
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	snapshot, err := fdc.LoadSnapshot(strings.NewReader(foodCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	matcher := fdc.NewMatcher(snapshot)
+
+	match, ok, err := matcher.Match(context.Background(), "Blueberries, Fresh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || match.Food.FDCID != 171705 || match.Score != 1 {
+		t.Fatalf("unexpected match: %+v", match)
+	}
+
+	match, ok, err = matcher.Match(context.Background(), "Kirkland Signature - Peanut Butter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatalf("expected no match but found %+v", match)
+	}
+}
+
+type countingSearcher struct {
+	fdc.Searcher
+	searches int
+}
+
+func (c *countingSearcher) Search(ctx context.Context, query string) ([]fdc.Food, error) {
+	c.searches++
+	return c.Searcher.Search(ctx, query)
+}
+
+func TestMatcher_MatchServings(t *testing.T) {
+	snapshot, err := fdc.LoadSnapshot(strings.NewReader(foodCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	searcher := &countingSearcher{Searcher: snapshot}
+	matcher := fdc.NewMatcher(searcher)
+
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Eggs, Scrambled"},
+		{FoodName: "Egg, scrambled"},
+		{FoodName: "Eggs, Scrambled"},
+		{FoodName: "Protein Bar"},
+	}
+	matches, err := matcher.MatchServings(context.Background(), servings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(matches) != 2 || matches["Eggs, Scrambled"].Food.FDCID != 171287 || matches["Egg, scrambled"].Food.FDCID != 171287 {
+		t.Fatalf("unexpected matches: %+v", matches)
+	}
+	if searcher.searches != 2 {
+		t.Fatalf("expected 2 searches of the normalized names but found %d", searcher.searches)
+	}
+}
@@ -0,0 +1,262 @@
+// Package fhir converts parsed Cronometer biometrics into FHIR R4 Observation resources so they can be loaded into
+// clinical systems. Metrics are coded with LOINC and amounts with UCUM units, following the FHIR vital signs profile.
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The following are the code systems used by the resources.
+const (
+	LOINCSystem               = "http://loinc.org"
+	UCUMSystem                = "http://unitsofmeasure.org"
+	ObservationCategorySystem = "http://terminology.hl7.org/CodeSystem/observation-category"
+)
+
+// Coding is a code defined by a terminology system.
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code"`
+	Display string `json:"display,omitempty"`
+}
+
+// CodeableConcept is a concept given by one or more codings.
+type CodeableConcept struct {
+	Coding []Coding `json:"coding"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Quantity is a measured amount with a UCUM unit.
+type Quantity struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit"`
+	System string  `json:"system"`
+	Code   string  `json:"code"`
+}
+
+// Reference refers to another resource, such as the patient the observation is about.
+type Reference struct {
+	Reference string `json:"reference"`
+}
+
+// Component is a part of an observation made up of several values, such as the systolic reading of a blood pressure.
+type Component struct {
+	Code          CodeableConcept `json:"code"`
+	ValueQuantity *Quantity       `json:"valueQuantity,omitempty"`
+}
+
+// Observation is a FHIR Observation resource.
+type Observation struct {
+	ResourceType      string            `json:"resourceType"`
+	Status            string            `json:"status"`
+	Category          []CodeableConcept `json:"category"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           *Reference        `json:"subject,omitempty"`
+	EffectiveDateTime string            `json:"effectiveDateTime"`
+	ValueQuantity     *Quantity         `json:"valueQuantity,omitempty"`
+	Component         []Component       `json:"component,omitempty"`
+}
+
+// observationType describes how a Cronometer metric maps to a LOINC coded observation.
+type observationType struct {
+	code    string
+	display string
+	units   map[string]string // Cronometer unit to UCUM unit.
+}
+
+// vitalSigns is the category given to every observation.
+var vitalSigns = CodeableConcept{
+	Coding: []Coding{{System: ObservationCategorySystem, Code: "vital-signs", Display: "Vital Signs"}},
+}
+
+// observationTypes maps the Cronometer metric names to LOINC codes. Metrics are matched without regard to case. Blood
+// glucose has different codes for mass and molar concentrations so it is handled by glucoseTypes instead.
+var observationTypes = map[string]observationType{
+	"weight": {
+		code:    "29463-7",
+		display: "Body weight",
+		units:   map[string]string{"kg": "kg", "lbs": "[lb_av]", "lb": "[lb_av]"},
+	},
+	"heart rate": {
+		code:    "8867-4",
+		display: "Heart rate",
+		units:   map[string]string{"bpm": "/min"},
+	},
+	"body fat": {
+		code:    "41982-0",
+		display: "Percentage of body fat Measured",
+		units:   map[string]string{"%": "%"},
+	},
+	"height": {
+		code:    "8302-2",
+		display: "Body height",
+		units:   map[string]string{"cm": "cm", "in": "[in_i]"},
+	},
+	"body temperature": {
+		code:    "8310-5",
+		display: "Body temperature",
+		units:   map[string]string{"°c": "Cel", "c": "Cel", "°f": "[degF]", "f": "[degF]"},
+	},
+	"bmi": {
+		code:    "39156-5",
+		display: "Body mass index (BMI) [Ratio]",
+		units:   map[string]string{"kg/m2": "kg/m2", "": "kg/m2"},
+	},
+}
+
+// glucoseTypes maps the Cronometer blood glucose units to LOINC codes.
+var glucoseTypes = map[string]observationType{
+	"mg/dl": {
+		code:    "2339-0",
+		display: "Glucose [Mass/volume] in Blood",
+		units:   map[string]string{"mg/dl": "mg/dL"},
+	},
+	"mmol/l": {
+		code:    "15074-8",
+		display: "Glucose [Moles/volume] in Blood",
+		units:   map[string]string{"mmol/l": "mmol/L"},
+	},
+}
+
+// FromBiometrics converts the biometric records to Observations about the subject, given as a reference such as
+// "Patient/123". The subject is omitted when empty. Records with metrics or units that have no LOINC mapping are
+// returned in skipped. Blood pressure is exported by Cronometer as "systolic/diastolic" and is only converted when
+// the records were parsed with gocronometer.WithKeepRaw, as the amount is otherwise lost.
+func FromBiometrics(records gocronometer.BiometricRecords, subject string) (converted []Observation, skipped gocronometer.BiometricRecords) {
+	var ref *Reference
+	if subject != "" {
+		ref = &Reference{Reference: subject}
+	}
+
+	for _, b := range records {
+		metric := strings.ToLower(strings.TrimSpace(b.Metric))
+		unit := strings.ToLower(strings.TrimSpace(b.Unit))
+
+		if metric == "blood pressure" {
+			o, ok := bloodPressure(b, ref)
+			if !ok {
+				skipped = append(skipped, b)
+				continue
+			}
+			converted = append(converted, o)
+			continue
+		}
+
+		t, ok := observationTypes[metric]
+		if metric == "blood glucose" {
+			t, ok = glucoseTypes[unit]
+		}
+		if !ok {
+			skipped = append(skipped, b)
+			continue
+		}
+
+		ucum, ok := t.units[unit]
+		if !ok {
+			skipped = append(skipped, b)
+			continue
+		}
+
+		o := newObservation(LOINCSystem, t.code, t.display, b, ref)
+		o.ValueQuantity = &Quantity{Value: b.Amount, Unit: ucum, System: UCUMSystem, Code: ucum}
+		converted = append(converted, o)
+	}
+
+	return converted, skipped
+}
+
+// newObservation creates a final vital signs observation with the code and time of the record.
+func newObservation(system string, code string, display string, b gocronometer.BiometricRecord, subject *Reference) Observation {
+	return Observation{
+		ResourceType: "Observation",
+		Status:       "final",
+		Category:     []CodeableConcept{vitalSigns},
+		Code: CodeableConcept{
+			Coding: []Coding{{System: system, Code: code, Display: display}},
+			Text:   b.Metric,
+		},
+		Subject:           subject,
+		EffectiveDateTime: effectiveDateTime(b),
+	}
+}
+
+// effectiveDateTime formats the time of the record. Records without a time of day are given only their date, which
+// FHIR allows for dateTime values.
+func effectiveDateTime(b gocronometer.BiometricRecord) string {
+	if !b.HasTime && !b.Day.IsZero() {
+		return b.Day.String()
+	}
+	return b.RecordedTime.Format(time.RFC3339)
+}
+
+// bloodPressure converts the raw systolic/diastolic amount of the record into a blood pressure panel.
+func bloodPressure(b gocronometer.BiometricRecord, subject *Reference) (Observation, bool) {
+	if b.Raw == nil {
+		return Observation{}, false
+	}
+
+	amount, ok := b.Raw.Get("Amount")
+	if !ok {
+		return Observation{}, false
+	}
+
+	parts := strings.SplitN(amount, "/", 2)
+	if len(parts) != 2 {
+		return Observation{}, false
+	}
+
+	systolic, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return Observation{}, false
+	}
+	diastolic, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return Observation{}, false
+	}
+
+	o := newObservation(LOINCSystem, "85354-9", "Blood pressure panel with all children optional", b, subject)
+	o.Component = []Component{
+		{
+			Code:          CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: "8480-6", Display: "Systolic blood pressure"}}},
+			ValueQuantity: &Quantity{Value: systolic, Unit: "mm[Hg]", System: UCUMSystem, Code: "mm[Hg]"},
+		},
+		{
+			Code:          CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: "8462-4", Display: "Diastolic blood pressure"}}},
+			ValueQuantity: &Quantity{Value: diastolic, Unit: "mm[Hg]", System: UCUMSystem, Code: "mm[Hg]"},
+		},
+	}
+	return o, true
+}
+
+// bundle is a FHIR collection Bundle.
+type bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Entry        []bundleEntry `json:"entry"`
+}
+
+// bundleEntry is an entry of a Bundle.
+type bundleEntry struct {
+	Resource Observation `json:"resource"`
+}
+
+// Write writes the observations to w as a FHIR collection Bundle.
+func Write(w io.Writer, observations []Observation) error {
+	b := bundle{ResourceType: "Bundle", Type: "collection", Entry: make([]bundleEntry, 0, len(observations))}
+	for _, o := range observations {
+		b.Entry = append(b.Entry, bundleEntry{Resource: o})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(b); err != nil {
+		return fmt.Errorf("encoding fhir bundle: %s", err)
+	}
+	return nil
+}
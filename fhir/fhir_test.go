@@ -0,0 +1,69 @@
+package fhir_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/fhir"
+	"strings"
+	"testing"
+)
+
+func TestFromBiometrics(t *testing.T) {
+	raw := "Day,Time,Metric,Unit,Amount\n" +
+		"2021-06-01,07:00,Weight,kg,80.5\n" +
+		"2021-06-01,07:05,Blood Pressure,mmHg,120/80\n" +
+		"2021-06-01,,Blood Glucose,mmol/L,5.4\n" +
+		"2021-06-01,07:10,Mood,,7\n"
+
+	records, err := gocronometer.ParseBiometrics(strings.NewReader(raw), gocronometer.WithKeepRaw())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	converted, skipped := fhir.FromBiometrics(records, "Patient/123")
+	if len(skipped) != 1 || skipped[0].Metric != "Mood" {
+		t.Fatalf("expected mood to be skipped but found %+v", skipped)
+	}
+
+	if len(converted) != 3 {
+		t.Fatalf("expected 3 observations but found %d", len(converted))
+	}
+
+	weight := converted[0]
+	if weight.Code.Coding[0].Code != "29463-7" || weight.ValueQuantity.Code != "kg" || weight.ValueQuantity.Value != 80.5 {
+		t.Fatalf("unexpected weight observation: %+v", weight)
+	}
+	if weight.EffectiveDateTime != "2021-06-01T07:00:00Z" || weight.Subject.Reference != "Patient/123" {
+		t.Fatalf("unexpected weight observation: %+v", weight)
+	}
+
+	bp := converted[1]
+	if bp.Code.Coding[0].Code != "85354-9" || len(bp.Component) != 2 ||
+		bp.Component[0].ValueQuantity.Value != 120 || bp.Component[1].Code.Coding[0].Code != "8462-4" {
+		t.Fatalf("unexpected blood pressure observation: %+v", bp)
+	}
+
+	glucose := converted[2]
+	if glucose.Code.Coding[0].Code != "15074-8" || glucose.EffectiveDateTime != "2021-06-01" {
+		t.Fatalf("unexpected glucose observation: %+v", glucose)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	records := gocronometer.BiometricRecords{{Metric: "Heart Rate", Unit: "bpm", Amount: 60, HasTime: true}}
+	converted, _ := fhir.FromBiometrics(records, "")
+
+	var buf bytes.Buffer
+	if err := fhir.Write(&buf, converted); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{`"resourceType": "Bundle"`, `"code": "8867-4"`, `"code": "/min"`} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected the bundle to contain %s but found %s", expected, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "subject") {
+		t.Fatalf("expected no subject but found %s", buf.String())
+	}
+}
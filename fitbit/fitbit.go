@@ -0,0 +1,172 @@
+// Package fitbit imports the weight and exercise logs of a Fitbit data export into the gocronometer record types,
+// and reconciles them with data Cronometer has already synced from Fitbit.
+//
+// The logs are the JSON files found in the Physical Activity folder of the Fitbit account export, such as
+// weight-2021-06-01.json and exercise-0.json.
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// The following are the layouts of the dates in the Fitbit logs.
+const (
+	weightDateLayout     = "01/02/06 15:04:05"
+	exerciseStartLayout  = "01/02/06 15:04:05"
+	defaultWeightLogTime = "00:00:00"
+)
+
+// weightLog is an entry of a weight-*.json file.
+type weightLog struct {
+	Weight float64  `json:"weight"`
+	Fat    *float64 `json:"fat"`
+	Date   string   `json:"date"`
+	Time   string   `json:"time"`
+}
+
+// exerciseLog is an entry of an exercise-*.json file.
+type exerciseLog struct {
+	ActivityName   string  `json:"activityName"`
+	ActiveDuration int64   `json:"activeDuration"` // Milliseconds.
+	Calories       float64 `json:"calories"`
+	StartTime      string  `json:"startTime"`
+}
+
+// ParseWeightLogs parses a weight log into biometric records. Fitbit does not record the unit of the weights, which
+// follow the unit setting of the account, so the unit must be provided as the Cronometer unit, "kg" or "lbs". Logs
+// with a body fat percentage also produce a "Body Fat" record. A nil location is treated as UTC.
+func ParseWeightLogs(r io.Reader, unit string, location *time.Location) (gocronometer.BiometricRecords, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	var logs []weightLog
+	if err := json.NewDecoder(r).Decode(&logs); err != nil {
+		return nil, fmt.Errorf("decoding fitbit weight logs: %s", err)
+	}
+
+	records := make(gocronometer.BiometricRecords, 0, len(logs))
+	for _, l := range logs {
+		timeStr := l.Time
+		if timeStr == "" {
+			timeStr = defaultWeightLogTime
+		}
+
+		t, err := time.ParseInLocation(weightDateLayout, l.Date+" "+timeStr, location)
+		if err != nil {
+			return nil, fmt.Errorf("parsing fitbit weight log time: %s", err)
+		}
+
+		record := gocronometer.BiometricRecord{
+			RecordedTime: t,
+			Day:          gocronometer.DateOf(t),
+			HasTime:      l.Time != "",
+			Metric:       "Weight",
+			Unit:         unit,
+			Amount:       l.Weight,
+		}
+		records = append(records, record)
+
+		if l.Fat != nil {
+			record.Metric = "Body Fat"
+			record.Unit = "%"
+			record.Amount = *l.Fat
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// ParseExerciseLogs parses an exercise log into exercise records. A nil location is treated as UTC.
+func ParseExerciseLogs(r io.Reader, location *time.Location) (gocronometer.ExerciseRecords, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	var logs []exerciseLog
+	if err := json.NewDecoder(r).Decode(&logs); err != nil {
+		return nil, fmt.Errorf("decoding fitbit exercise logs: %s", err)
+	}
+
+	records := make(gocronometer.ExerciseRecords, 0, len(logs))
+	for _, l := range logs {
+		t, err := time.ParseInLocation(exerciseStartLayout, l.StartTime, location)
+		if err != nil {
+			return nil, fmt.Errorf("parsing fitbit exercise start time: %s", err)
+		}
+
+		records = append(records, gocronometer.ExerciseRecord{
+			RecordedTime:   t,
+			Day:            gocronometer.DateOf(t),
+			HasTime:        true,
+			Exercise:       l.ActivityName,
+			Minutes:        float64(l.ActiveDuration) / float64(time.Minute/time.Millisecond),
+			CaloriesBurned: l.Calories,
+		})
+	}
+
+	return records, nil
+}
+
+// kgPerPound is the number of kilograms in a pound.
+const kgPerPound = 0.45359237
+
+// normalizedAmount converts weights to kilograms so records in different units can be compared.
+func normalizedAmount(b gocronometer.BiometricRecord) float64 {
+	switch strings.ToLower(b.Unit) {
+	case "lbs", "lb":
+		return b.Amount * kgPerPound
+	default:
+		return b.Amount
+	}
+}
+
+// MergeBiometrics combines the biometrics from Cronometer with those imported from Fitbit. Cronometer syncs
+// Fitbit weigh-ins itself, so an imported record is dropped when Cronometer has a record of the same metric on the same
+// day with an amount within tolerance, comparing weights in kilograms. The result is sorted by time.
+func MergeBiometrics(cronometer gocronometer.BiometricRecords, imported gocronometer.BiometricRecords, tolerance float64) gocronometer.BiometricRecords {
+	var missing gocronometer.BiometricRecords
+	for _, f := range imported {
+		synced := false
+		for _, c := range cronometer {
+			if c.Day == f.Day && strings.EqualFold(c.Metric, f.Metric) &&
+				math.Abs(normalizedAmount(c)-normalizedAmount(f)) <= tolerance {
+				synced = true
+				break
+			}
+		}
+		if !synced {
+			missing = append(missing, f)
+		}
+	}
+
+	return gocronometer.MergeBiometrics(cronometer, missing)
+}
+
+// MergeExercises combines the exercises from Cronometer with those imported from Fitbit. An imported exercise is
+// dropped when Cronometer has an exercise with the same name on the same day and minutes within one minute. The result
+// is sorted by time.
+func MergeExercises(cronometer gocronometer.ExerciseRecords, imported gocronometer.ExerciseRecords) gocronometer.ExerciseRecords {
+	var missing gocronometer.ExerciseRecords
+	for _, f := range imported {
+		synced := false
+		for _, c := range cronometer {
+			if c.Day == f.Day && strings.EqualFold(c.Exercise, f.Exercise) && math.Abs(c.Minutes-f.Minutes) <= 1 {
+				synced = true
+				break
+			}
+		}
+		if !synced {
+			missing = append(missing, f)
+		}
+	}
+
+	return gocronometer.MergeExercises(cronometer, missing)
+}
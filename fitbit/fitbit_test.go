@@ -0,0 +1,69 @@
+package fitbit_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/fitbit"
+	"strings"
+	"testing"
+	"time"
+)
+
+const weightLogs = `[
+	{"logId": 1, "weight": 180.2, "bmi": 25.1, "fat": 20.5, "date": "06/01/21", "time": "07:30:00", "source": "Aria"},
+	{"logId": 2, "weight": 179.8, "bmi": 25.0, "date": "06/02/21", "time": "07:45:10", "source": "Aria"}
+]`
+
+func TestParseWeightLogs(t *testing.T) {
+	records, err := fitbit.ParseWeightLogs(strings.NewReader(weightLogs), "lbs", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records but found %d", len(records))
+	}
+
+	if records[1].Metric != "Body Fat" || records[1].Amount != 20.5 {
+		t.Fatalf("unexpected body fat record: %+v", records[1])
+	}
+
+	expected := time.Date(2021, 6, 2, 7, 45, 10, 0, time.UTC)
+	if !records[2].RecordedTime.Equal(expected) {
+		t.Fatalf("expected %s but found %s", expected, records[2].RecordedTime)
+	}
+}
+
+func TestMergeBiometrics(t *testing.T) {
+	imported, err := fitbit.ParseWeightLogs(strings.NewReader(weightLogs), "lbs", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Cronometer synced the first weigh-in in kilograms.
+	cronometer := gocronometer.BiometricRecords{
+		{Day: gocronometer.Date{Year: 2021, Month: time.June, Day: 1}, RecordedTime: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+			Metric: "Weight", Unit: "kg", Amount: 81.74},
+	}
+
+	merged := fitbit.MergeBiometrics(cronometer, imported, 0.1)
+	if len(merged) != 3 {
+		t.Fatalf("expected the synced weigh-in to be dropped but found %+v", merged)
+	}
+
+	if merged[0].Unit != "kg" {
+		t.Fatalf("expected the Cronometer record to be kept but found %+v", merged[0])
+	}
+}
+
+func TestParseExerciseLogs(t *testing.T) {
+	logs := `[{"logId": 1, "activityName": "Walk", "activeDuration": 1800000, "calories": 150, "startTime": "06/01/21 18:00:00"}]`
+
+	records, err := fitbit.ParseExerciseLogs(strings.NewReader(logs), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if records[0].Exercise != "Walk" || records[0].Minutes != 30 || records[0].CaloriesBurned != 150 {
+		t.Fatalf("unexpected exercise: %+v", records[0])
+	}
+}
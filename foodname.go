@@ -0,0 +1,109 @@
+package gocronometer
+
+import (
+	"strings"
+)
+
+// PreparationQualifiers are the descriptions of how a food was prepared or stored that StripPreparation removes from
+// food names. They are matched against whole comma separated parts of the name without regard to case, and can be
+// extended by appending to them.
+var PreparationQualifiers = []string{
+	"raw", "cooked", "uncooked", "boiled", "hard-boiled", "baked", "fried", "pan-fried", "deep-fried", "grilled",
+	"roasted", "dry roasted", "steamed", "poached", "broiled", "braised", "stewed", "sauteed", "microwaved",
+	"toasted", "scrambled", "prepared", "unprepared", "ready-to-eat", "fresh", "frozen", "canned", "drained",
+	"dried", "with salt", "without salt", "no salt added",
+}
+
+// SplitBrand splits the food name into the brand and the item, for names in the "Brand - Item" form used for branded
+// foods, such as "Kirkland Signature - Organic Peanut Butter". The brand is empty and the item is the whole name,
+// with its whitespace normalized, when the name has no brand.
+func SplitBrand(name string) (brand string, item string) {
+	name = normalizeSpace(name)
+	brand, item, ok := strings.Cut(name, " - ")
+	if !ok || brand == "" || item == "" {
+		return "", name
+	}
+
+	return brand, item
+}
+
+// StripPreparation removes the comma separated parts of the food name that are PreparationQualifiers, so "Egg, large,
+// cooked" becomes "Egg, large". The first part is always kept, as it names the food.
+func StripPreparation(name string) string {
+	parts := splitFoodName(name)
+	kept := parts[:1]
+	for _, part := range parts[1:] {
+		if !isPreparationQualifier(part) {
+			kept = append(kept, part)
+		}
+	}
+
+	return strings.Join(kept, ", ")
+}
+
+// NormalizeFoodName returns the key of the food name for grouping the servings of the same food logged under
+// slightly different names, such as "Eggs, Large" and "Egg, large, cooked", which both become "egg, large". The
+// brand and the preparation qualifiers are removed, the name is lower cased with its whitespace normalized, and the
+// last word of the first part is made singular. The key is meant for grouping rather than for display:
+//
+//	byFood := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) string {
+//		return gocronometer.NormalizeFoodName(s.FoodName)
+//	})
+func NormalizeFoodName(name string) string {
+	_, item := SplitBrand(name)
+	parts := splitFoodName(strings.ToLower(StripPreparation(item)))
+
+	words := strings.Fields(parts[0])
+	if len(words) > 0 {
+		words[len(words)-1] = singular(words[len(words)-1])
+	}
+	parts[0] = strings.Join(words, " ")
+
+	return strings.Join(parts, ", ")
+}
+
+// splitFoodName splits the food name into its comma separated parts with their whitespace normalized. Empty parts are
+// dropped, except that a single empty part is returned for an empty name.
+func splitFoodName(name string) []string {
+	parts := make([]string, 0)
+	for _, part := range strings.Split(name, ",") {
+		if part = normalizeSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "")
+	}
+
+	return parts
+}
+
+// isPreparationQualifier reports whether the part of a food name is one of the PreparationQualifiers.
+func isPreparationQualifier(part string) bool {
+	for _, q := range PreparationQualifiers {
+		if strings.EqualFold(part, q) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// singular returns the singular of the lower case English word for the regular plurals found in food names, such as
+// "eggs", "berries" and "tomatoes". Words that do not look plural, such as "hummus" or "asparagus", are unchanged.
+func singular(word string) string {
+	switch {
+	case len(word) <= 3:
+		return word
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "oes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "ss"), strings.HasSuffix(word, "us"), strings.HasSuffix(word, "is"):
+		return word
+	case strings.HasSuffix(word, "s"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
@@ -0,0 +1,51 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestNormalizeFoodName(t *testing.T) {
+	cases := map[string]string{
+		"Eggs, Large":                           "egg, large",
+		"Egg, large, cooked":                    "egg, large",
+		"  Egg ,  LARGE , Hard-Boiled ":         "egg, large",
+		"Blueberries, Frozen":                   "blueberry",
+		"Cherry Tomatoes":                       "cherry tomato",
+		"Hummus":                                "hummus",
+		"Asparagus, Raw":                        "asparagus",
+		"Peaches, Canned, Drained":              "peach",
+		"Oats":                                  "oat",
+		"Kirkland Signature - Almonds, Roasted": "almond",
+		"":                                      "",
+	}
+
+	for name, expected := range cases {
+		if normalized := gocronometer.NormalizeFoodName(name); normalized != expected {
+			t.Fatalf("expected %q to normalize to %q but found %q", name, expected, normalized)
+		}
+	}
+}
+
+func TestSplitBrand(t *testing.T) {
+	brand, item := gocronometer.SplitBrand("Kirkland Signature  -  Organic Peanut Butter")
+	if brand != "Kirkland Signature" || item != "Organic Peanut Butter" {
+		t.Fatalf("unexpected brand %q and item %q", brand, item)
+	}
+
+	brand, item = gocronometer.SplitBrand("Coca-Cola")
+	if brand != "" || item != "Coca-Cola" {
+		t.Fatalf("expected no brand but found %q and item %q", brand, item)
+	}
+}
+
+func TestStripPreparation(t *testing.T) {
+	if stripped := gocronometer.StripPreparation("Chicken Breast, Skinless, Grilled"); stripped != "Chicken Breast, Skinless" {
+		t.Fatalf("unexpected stripped name %q", stripped)
+	}
+
+	// The first part names the food and is kept.
+	if stripped := gocronometer.StripPreparation("Raw, Honey"); stripped != "Raw, Honey" {
+		t.Fatalf("unexpected stripped name %q", stripped)
+	}
+}
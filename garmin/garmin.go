@@ -0,0 +1,132 @@
+// Package garmin imports the weight and body composition CSV exported from Garmin Connect into the gocronometer
+// biometric records.
+//
+// Garmin Connect writes each day as a row holding only the date, such as " Jun 1, 2021", followed by a row for each
+// weigh-in on that day starting with its time. Amounts carry their unit, for example "79.4 kg" or "18.2 %", and
+// amounts that were not measured are written as "--".
+package garmin
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The following are the layouts of the date and time rows.
+const (
+	dateLayout = "Jan 2, 2006"
+	timeLayout = "3:04 PM"
+)
+
+// metrics maps the Garmin Connect columns to the Cronometer metric names. Columns not listed, such as Change, are
+// ignored.
+var metrics = map[string]string{
+	"Weight":               "Weight",
+	"BMI":                  "BMI",
+	"Body Fat":             "Body Fat",
+	"Skeletal Muscle Mass": "Skeletal Muscle Mass",
+	"Bone Mass":            "Bone Mass",
+	"Body Water":           "Body Water",
+}
+
+// ParseWeightExport parses a Garmin Connect weight export into biometric records, producing a record for each
+// measured column of each weigh-in. A nil location is treated as UTC.
+func ParseWeightExport(r io.Reader, location *time.Location) (gocronometer.BiometricRecords, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading garmin headers: %s", err)
+	}
+
+	var (
+		records gocronometer.BiometricRecords
+		day     gocronometer.Date
+		row     = 1
+	)
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("reading garmin row %d: %s", row, err)
+		}
+
+		first := strings.TrimSpace(fields[0])
+		if first == "" {
+			continue
+		}
+
+		if d, err := time.Parse(dateLayout, first); err == nil {
+			day = gocronometer.DateOf(d)
+			continue
+		}
+
+		if day.IsZero() {
+			return nil, fmt.Errorf("garmin row %d has no preceding date row", row)
+		}
+
+		clock, err := time.Parse(timeLayout, first)
+		if err != nil {
+			return nil, fmt.Errorf("parsing garmin row %d time: %s", row, err)
+		}
+		recorded := time.Date(day.Year, day.Month, day.Day, clock.Hour(), clock.Minute(), 0, 0, location)
+
+		for i, header := range headers {
+			metric, ok := metrics[strings.TrimSpace(header)]
+			if !ok || i >= len(fields) {
+				continue
+			}
+
+			amount, unit, ok, err := parseAmount(fields[i])
+			if err != nil {
+				return nil, fmt.Errorf("parsing garmin row %d %s: %s", row, header, err)
+			}
+			if !ok {
+				continue
+			}
+
+			records = append(records, gocronometer.BiometricRecord{
+				RecordedTime: recorded,
+				Day:          day,
+				HasTime:      true,
+				Metric:       metric,
+				Unit:         unit,
+				Amount:       amount,
+			})
+		}
+	}
+
+	return records, nil
+}
+
+// parseAmount splits an amount such as "79.4 kg" into its value and unit. Amounts that were not measured are
+// reported as not ok.
+func parseAmount(s string) (amount float64, unit string, ok bool, err error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "--" {
+		return 0, "", false, nil
+	}
+
+	value := s
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		value, unit = s[:i], strings.TrimSpace(s[i+1:])
+	}
+
+	amount, err = strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+	if err != nil {
+		return 0, "", false, err
+	}
+	return amount, unit, true, nil
+}
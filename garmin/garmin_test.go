@@ -0,0 +1,47 @@
+package garmin_test
+
+import (
+	"github.com/burke/gocronometer/garmin"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWeightExport(t *testing.T) {
+	raw := "Time,Weight,Change,BMI,Body Fat,Skeletal Muscle Mass,Bone Mass,Body Water,\n" +
+		"\" Jun 1, 2021\",\n" +
+		"7:30 AM,79.4 kg,0.2 kg,24.5,18.2 %,35.1 kg,--,57.3 %,\n" +
+		"\" Jun 2, 2021\",\n" +
+		"6:45 PM,\"1,079.0 kg\",--,--,--,--,--,--,\n"
+
+	records, err := garmin.ParseWeightExport(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 6 {
+		t.Fatalf("expected 6 records but found %d: %+v", len(records), records)
+	}
+
+	if records[0].Metric != "Weight" || records[0].Unit != "kg" || records[0].Amount != 79.4 {
+		t.Fatalf("unexpected weight record: %+v", records[0])
+	}
+
+	if records[1].Metric != "BMI" || records[1].Unit != "" || records[1].Amount != 24.5 {
+		t.Fatalf("unexpected bmi record: %+v", records[1])
+	}
+
+	last := records[5]
+	expected := time.Date(2021, 6, 2, 18, 45, 0, 0, time.UTC)
+	if last.Amount != 1079 || !last.RecordedTime.Equal(expected) {
+		t.Fatalf("unexpected record: %+v", last)
+	}
+}
+
+func TestParseWeightExport_MissingDate(t *testing.T) {
+	raw := "Time,Weight\n7:30 AM,79.4 kg\n"
+
+	if _, err := garmin.ParseWeightExport(strings.NewReader(raw), nil); err == nil {
+		t.Fatal("expected an error for a weigh-in without a date row")
+	}
+}
@@ -0,0 +1,156 @@
+// Package glycemic estimates the glycemic load of servings for users managing their blood sugar. The glycemic load of
+// a serving is its glycemic index multiplied by its available carbohydrates in grams, divided by 100.
+//
+// Cronometer does not export the glycemic index of foods, so it is taken from a Lookup provided by the caller, such as
+// a Table of published values. Foods missing from the lookup have their glycemic index estimated from the sugars and
+// starch of the serving, which is only a rough approximation as it ignores the fiber, fat and processing of the food.
+package glycemic
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"strings"
+)
+
+// The following are the glycemic indexes of the carbohydrates used to estimate the glycemic index of servings missing
+// from the lookup. Sugars of an unknown type are treated as sucrose.
+const (
+	GlucoseIndex   = 100
+	MaltoseIndex   = 105
+	SucroseIndex   = 65
+	LactoseIndex   = 46
+	GalactoseIndex = 20
+	FructoseIndex  = 19
+	StarchIndex    = 70
+)
+
+// The following are the thresholds commonly used to classify the glycemic load of a serving and of a day.
+const (
+	LowServingLoad  = 10
+	HighServingLoad = 20
+	LowDailyLoad    = 80
+	HighDailyLoad   = 120
+)
+
+// Lookup provides the glycemic index of a serving.
+type Lookup interface {
+	// GlycemicIndex returns the glycemic index of the serving, or false if it is not known.
+	GlycemicIndex(serving gocronometer.ServingRecord) (float64, bool)
+}
+
+// LookupFunc adapts a function to a Lookup.
+type LookupFunc func(serving gocronometer.ServingRecord) (float64, bool)
+
+// GlycemicIndex calls f.
+func (f LookupFunc) GlycemicIndex(serving gocronometer.ServingRecord) (float64, bool) {
+	return f(serving)
+}
+
+// Table is a Lookup of the glycemic index of foods by their name, matched without regard to case.
+type Table map[string]float64
+
+// GlycemicIndex returns the glycemic index of the food of the serving.
+func (t Table) GlycemicIndex(serving gocronometer.ServingRecord) (float64, bool) {
+	if gi, ok := t[serving.FoodName]; ok {
+		return gi, true
+	}
+	for name, gi := range t {
+		if strings.EqualFold(name, serving.FoodName) {
+			return gi, true
+		}
+	}
+
+	return 0, false
+}
+
+// Load is the glycemic load of a serving.
+type Load struct {
+	// GlycemicIndex is the glycemic index of the serving.
+	GlycemicIndex float64
+
+	// AvailableCarbsG are the carbohydrates of the serving in grams, excluding fiber.
+	AvailableCarbsG float64
+
+	// GlycemicLoad is the glycemic load of the serving.
+	GlycemicLoad float64
+
+	// Estimated is true when the glycemic index was estimated because the serving was missing from the lookup.
+	Estimated bool
+}
+
+// Serving calculates the glycemic load of the serving. The glycemic index is taken from lookup, which may be nil, and
+// is estimated when the serving is missing from it.
+func Serving(serving gocronometer.ServingRecord, lookup Lookup) Load {
+	load := Load{AvailableCarbsG: availableCarbs(serving)}
+	if load.AvailableCarbsG <= 0 {
+		return Load{}
+	}
+
+	gi, ok := 0.0, false
+	if lookup != nil {
+		gi, ok = lookup.GlycemicIndex(serving)
+	}
+	if !ok {
+		gi = EstimateIndex(serving)
+		load.Estimated = true
+	}
+
+	load.GlycemicIndex = gi
+	load.GlycemicLoad = gi * load.AvailableCarbsG / 100
+	return load
+}
+
+// Total returns the sum of the glycemic load of the servings.
+func Total(servings gocronometer.ServingRecords, lookup Lookup) float64 {
+	var total float64
+	for _, s := range servings {
+		total += Serving(s, lookup).GlycemicLoad
+	}
+
+	return total
+}
+
+// Daily returns the glycemic load of each day of the servings.
+func Daily(servings gocronometer.ServingRecords, lookup Lookup) map[gocronometer.Date]float64 {
+	daily := make(map[gocronometer.Date]float64)
+	for day, s := range gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) gocronometer.Date { return s.Day }) {
+		daily[day] = Total(s, lookup)
+	}
+
+	return daily
+}
+
+// EstimateIndex estimates the glycemic index of the serving as the average of the glycemic indexes of its sugars and
+// starch, weighted by their grams. Available carbohydrates that are not sugars are treated as starch. Zero is returned
+// for servings without available carbohydrates.
+func EstimateIndex(serving gocronometer.ServingRecord) float64 {
+	available := availableCarbs(serving)
+	if available <= 0 {
+		return 0
+	}
+
+	known := serving.GlucoseG + serving.MaltoseG + serving.SucroseG + serving.LactoseG + serving.GalactoseG +
+		serving.FructoseG
+	weighted := serving.GlucoseG*GlucoseIndex + serving.MaltoseG*MaltoseIndex + serving.SucroseG*SucroseIndex +
+		serving.LactoseG*LactoseIndex + serving.GalactoseG*GalactoseIndex + serving.FructoseG*FructoseIndex
+
+	// The sugars are never more than the available carbohydrates, unless the types of sugar add up to more.
+	sugars := math.Max(math.Min(serving.SugarsG, available), known)
+	if unknown := sugars - known; unknown > 0 {
+		weighted += unknown * SucroseIndex
+	}
+	if starch := available - sugars; starch > 0 {
+		weighted += starch * StarchIndex
+	}
+
+	return weighted / math.Max(available, sugars)
+}
+
+// availableCarbs returns the carbohydrates of the serving that raise blood sugar, which are the net carbs. The net
+// carbs are calculated from the carbs and fiber for exports without them.
+func availableCarbs(serving gocronometer.ServingRecord) float64 {
+	if serving.NetCarbsG != 0 {
+		return serving.NetCarbsG
+	}
+	return serving.CarbsG - serving.FiberG
+}
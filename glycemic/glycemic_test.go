@@ -0,0 +1,56 @@
+package glycemic_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/glycemic"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestServing(t *testing.T) {
+	rice := gocronometer.ServingRecord{FoodName: "White Rice", CarbsG: 45, FiberG: 1}
+	table := glycemic.Table{"white rice": 73}
+
+	load := glycemic.Serving(rice, table)
+	if load.Estimated || load.GlycemicIndex != 73 || load.AvailableCarbsG != 44 {
+		t.Fatalf("unexpected load: %+v", load)
+	}
+	if math.Abs(load.GlycemicLoad-32.12) > 1e-9 {
+		t.Fatalf("expected a glycemic load of 32.12 but found %f", load.GlycemicLoad)
+	}
+
+	load = glycemic.Serving(rice, nil)
+	if !load.Estimated || load.GlycemicIndex != glycemic.StarchIndex {
+		t.Fatalf("expected the index of starch to be estimated but found %+v", load)
+	}
+
+	if load := glycemic.Serving(gocronometer.ServingRecord{FoodName: "Eggs", FatG: 10}, table); load.GlycemicLoad != 0 {
+		t.Fatalf("expected no load for a serving without carbs but found %+v", load)
+	}
+}
+
+func TestEstimateIndex(t *testing.T) {
+	// An apple: most sugar is fructose, with some glucose and sucrose and a little starch.
+	apple := gocronometer.ServingRecord{NetCarbsG: 21, SugarsG: 19, FructoseG: 11, GlucoseG: 4, SucroseG: 3}
+	expected := (11*glycemic.FructoseIndex + 4*glycemic.GlucoseIndex + 3*glycemic.SucroseIndex +
+		1*glycemic.SucroseIndex + 2*glycemic.StarchIndex) / 21.0
+	if gi := glycemic.EstimateIndex(apple); math.Abs(gi-expected) > 1e-9 {
+		t.Fatalf("expected an index of %f but found %f", expected, gi)
+	}
+}
+
+func TestDaily(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	lookup := glycemic.LookupFunc(func(gocronometer.ServingRecord) (float64, bool) { return 50, true })
+	servings := gocronometer.ServingRecords{
+		{Day: day, CarbsG: 40},
+		{Day: day, CarbsG: 20},
+		{Day: day.AddDays(1), CarbsG: 10},
+	}
+
+	daily := glycemic.Daily(servings, lookup)
+	if len(daily) != 2 || daily[day] != 30 || daily[day.AddDays(1)] != 5 {
+		t.Fatalf("unexpected daily loads: %v", daily)
+	}
+}
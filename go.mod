@@ -1,5 +1,17 @@
 module github.com/burke/gocronometer
 
-go 1.14
+go 1.23
 
-require golang.org/x/net v0.23.0
+require (
+	github.com/lib/pq v1.10.9
+	golang.org/x/net v0.23.0
+	google.golang.org/grpc v1.54.0
+	google.golang.org/protobuf v1.30.0
+)
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	google.golang.org/genproto v0.0.0-20230110181048-76db0878b65f // indirect
+)
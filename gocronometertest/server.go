@@ -0,0 +1,263 @@
+// Package gocronometertest provides a fake Cronometer server for testing code built on the client end to end, without
+// real credentials:
+//
+//	server := gocronometertest.NewServer("user", "pass")
+//	defer server.Close()
+//	server.SetExport("servings", "Day,Time,Group,Food Name,Amount,Energy (kcal)\n2021-06-01,08:00,Breakfast,Eggs,2 large,143\n")
+//
+//	client := server.Client(nil)
+//	err := client.Login(ctx, "user", "pass")
+//	servings, err := client.ExportServingsParsed(ctx, start, end)
+//
+// The server implements the login page, login, the GWT authentication, token generation and logout calls, and the
+// export endpoint. Exports serve the canned CSV of their type, filtered to the rows of the requested days.
+package gocronometertest
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// UserID is the user ID of the fake account.
+const UserID = "1234567"
+
+// Server is a fake Cronometer server. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for gocronometer.ClientOptions.BaseURL.
+	URL string
+
+	server   *httptest.Server
+	username string
+	password string
+
+	mu       sync.Mutex
+	exports  map[string]string
+	sessions map[string]bool
+	tokens   map[string]bool
+	requests map[string]int
+}
+
+// NewServer starts a fake Cronometer server accepting the credentials. It must be closed once done.
+func NewServer(username string, password string) *Server {
+	s := &Server{
+		username: username,
+		password: password,
+		exports:  make(map[string]string),
+		sessions: make(map[string]bool),
+		tokens:   make(map[string]bool),
+		requests: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/", s.handleLoginPage)
+	mux.HandleFunc("/login", s.handleLogin)
+	mux.HandleFunc("/cronometer/app", s.handleGWT)
+	mux.HandleFunc("/export", s.handleExport)
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client creates a client of the server. The BaseURL of the options is replaced by the URL of the server, and a nil
+// opts uses the defaults.
+func (s *Server) Client(opts *gocronometer.ClientOptions) *gocronometer.Client {
+	var o gocronometer.ClientOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.BaseURL = s.URL
+
+	return gocronometer.NewClient(&o)
+}
+
+// SetExport sets the CSV served for the type of export, as requested by the client, such as "servings",
+// "exercises", "biometrics", "notes" or "dailySummary". Exports without a CSV are served empty.
+func (s *Server) SetExport(generate string, csv string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.exports[generate] = csv
+}
+
+// ExpireSessions invalidates every session and export token, as when the session cookie expires, so the next
+// requests of the clients fail until they log in again.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions = make(map[string]bool)
+	s.tokens = make(map[string]bool)
+}
+
+// Requests returns the number of requests of the type of export served.
+func (s *Server) Requests(generate string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[generate]
+}
+
+func (s *Server) handleLoginPage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	fmt.Fprint(w, `<html><body><form method="post"><input type="hidden" name="anticsrf" value="anticsrf"></form></body></html>`)
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.FormValue("anticsrf") != "anticsrf" {
+		_ = json.NewEncoder(w).Encode(gocronometer.LoginResponse{Error: "Invalid request, please reload the page."})
+		return
+	}
+	if r.FormValue("username") != s.username || r.FormValue("password") != s.password {
+		_ = json.NewEncoder(w).Encode(gocronometer.LoginResponse{Error: "Your email or password was entered incorrectly."})
+		return
+	}
+
+	nonce := randomString()
+	s.mu.Lock()
+	s.sessions[nonce] = true
+	s.mu.Unlock()
+
+	http.SetCookie(w, &http.Cookie{Name: "sesnonce", Value: nonce, Path: "/"})
+	_ = json.NewEncoder(w).Encode(gocronometer.LoginResponse{Success: true, Redirect: "/"})
+}
+
+// handleGWT answers the GWT procedure calls of the client, identified by the procedure of the string table.
+func (s *Server) handleGWT(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	fields := strings.Split(string(body), "|")
+	if len(fields) < 7 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	cookie, err := r.Cookie("sesnonce")
+	s.mu.Lock()
+	valid := err == nil && s.sessions[cookie.Value]
+	s.mu.Unlock()
+
+	switch fields[6] {
+	case "authenticate":
+		if !valid {
+			fmt.Fprint(w, `//EX[2,1,["com.cronometer.shared.rpc.exceptions.NotLoggedInException/1",""],0,7]`)
+			return
+		}
+		fmt.Fprintf(w, "//OK[%s,1,[],0,7]", UserID)
+	case "generateAuthorizationToken":
+		if !valid {
+			fmt.Fprint(w, `//EX[2,1,["com.cronometer.shared.rpc.exceptions.NotLoggedInException/1",""],0,7]`)
+			return
+		}
+		token := randomString()
+		s.mu.Lock()
+		s.tokens[token] = true
+		s.mu.Unlock()
+		fmt.Fprintf(w, `//OK[1,["%s"],0,7]`, token)
+	case "logout":
+		if valid {
+			s.mu.Lock()
+			delete(s.sessions, cookie.Value)
+			s.mu.Unlock()
+		}
+		fmt.Fprint(w, "//OK[[],0,7]")
+	default:
+		http.Error(w, "unknown procedure", http.StatusNotImplemented)
+	}
+}
+
+func (s *Server) handleExport(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	generate := q.Get("generate")
+
+	s.mu.Lock()
+	valid := s.tokens[q.Get("nonce")]
+	delete(s.tokens, q.Get("nonce"))
+	export := s.exports[generate]
+	s.requests[generate]++
+	s.mu.Unlock()
+
+	if !valid {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	filtered, err := filterDays(export, q.Get("start"), q.Get("end"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	fmt.Fprint(w, filtered)
+}
+
+// filterDays returns the rows of the CSV export whose "Day" or "Date" column is within start and end, inclusive.
+// Exports without either column are returned unchanged.
+func filterDays(export string, start string, end string) (string, error) {
+	if strings.TrimSpace(export) == "" {
+		return export, nil
+	}
+
+	records, err := csv.NewReader(strings.NewReader(export)).ReadAll()
+	if err != nil {
+		return "", fmt.Errorf("reading canned export: %w", err)
+	}
+
+	column := -1
+	for i, header := range records[0] {
+		if header == "Day" || header == "Date" {
+			column = i
+			break
+		}
+	}
+	if column < 0 {
+		return export, nil
+	}
+
+	var b strings.Builder
+	cw := csv.NewWriter(&b)
+	kept := [][]string{records[0]}
+	for _, record := range records[1:] {
+		// The days are formatted as YYYY-MM-DD, which sort in the order of the dates.
+		if day := record[column]; day >= start && day <= end {
+			kept = append(kept, record)
+		}
+	}
+	if err := cw.WriteAll(kept); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// randomString returns a random hex string for nonces and tokens.
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
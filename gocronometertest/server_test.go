@@ -0,0 +1,56 @@
+package gocronometertest_test
+
+import (
+	"context"
+	"errors"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/gocronometertest"
+	"testing"
+	"time"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := gocronometertest.NewServer("user", "pass")
+	defer server.Close()
+	server.SetExport("servings", "Day,Time,Group,Food Name,Energy (kcal)\n2021-06-01,08:00,Breakfast,Eggs,143\n2021-06-03,12:00,Lunch,Soup,210\n")
+
+	client := server.Client(nil)
+	if err := client.Login(ctx, "user", "wrong"); !errors.Is(err, gocronometer.ErrAuthFailed) {
+		t.Fatalf("expected the login to fail but found %v", err)
+	}
+	if err := client.Login(ctx, "user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	if client.UserID != gocronometertest.UserID {
+		t.Fatalf("expected the user ID %s but found %s", gocronometertest.UserID, client.UserID)
+	}
+
+	start := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	servings, err := client.ExportServingsParsed(ctx, start, start.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servings) != 1 || servings[0].FoodName != "Eggs" {
+		t.Fatalf("expected the servings of the range but found %+v", servings)
+	}
+
+	// The client logs in again once the session expires.
+	server.ExpireSessions()
+	servings, err = client.ExportServingsParsed(ctx, start, start.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servings) != 2 || server.Requests("servings") != 2 {
+		t.Fatalf("unexpected servings %+v after %d requests", servings, server.Requests("servings"))
+	}
+
+	notes, err := client.ExportNotes(ctx, start, start)
+	if err != nil || notes != "" {
+		t.Fatalf("expected an empty export but found %q, %v", notes, err)
+	}
+
+	if err := client.Logout(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
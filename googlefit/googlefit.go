@@ -0,0 +1,340 @@
+// Package googlefit maps parsed Cronometer exports to the Google Fit REST API types and pushes them into a Fit
+// account. Servings are pushed as com.google.nutrition data points and weight biometrics as com.google.weight data
+// points.
+//
+// Authentication is left to the caller. The Client must be given an *http.Client that adds OAuth 2.0 credentials
+// with the fitness.nutrition.write and fitness.body.write scopes, such as one created by golang.org/x/oauth2.
+package googlefit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BaseURL is the base URL of the Google Fit REST API.
+const BaseURL = "https://www.googleapis.com/fitness/v1/users/me"
+
+// The following are the Google Fit data types produced by this package.
+const (
+	NutritionDataType = "com.google.nutrition"
+	WeightDataType    = "com.google.weight"
+)
+
+// The following are the meal types of com.google.nutrition data points.
+const (
+	MealTypeUnknown   = 1
+	MealTypeBreakfast = 2
+	MealTypeLunch     = 3
+	MealTypeDinner    = 4
+	MealTypeSnack     = 5
+)
+
+// DataSource is a Google Fit data source.
+type DataSource struct {
+	DataStreamID   string      `json:"dataStreamId,omitempty"`
+	DataStreamName string      `json:"dataStreamName,omitempty"`
+	Type           string      `json:"type"`
+	Application    Application `json:"application"`
+	DataType       DataType    `json:"dataType"`
+}
+
+// Application identifies the application that created a data source.
+type Application struct {
+	Name string `json:"name"`
+}
+
+// DataType is the type of the data points of a data source.
+type DataType struct {
+	Name  string  `json:"name"`
+	Field []Field `json:"field"`
+}
+
+// Field is a field of a data type.
+type Field struct {
+	Name     string `json:"name"`
+	Format   string `json:"format"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// Dataset is a collection of data points of a data source within a time range.
+type Dataset struct {
+	DataSourceID   string      `json:"dataSourceId"`
+	MinStartTimeNs int64       `json:"minStartTimeNs,string"`
+	MaxEndTimeNs   int64       `json:"maxEndTimeNs,string"`
+	Point          []DataPoint `json:"point"`
+}
+
+// DataPoint is a single Google Fit data point.
+type DataPoint struct {
+	DataTypeName   string  `json:"dataTypeName"`
+	StartTimeNanos int64   `json:"startTimeNanos,string"`
+	EndTimeNanos   int64   `json:"endTimeNanos,string"`
+	Value          []Value `json:"value"`
+}
+
+// Value is a single value of a data point. Only one of the fields is set.
+type Value struct {
+	FpVal  *float64   `json:"fpVal,omitempty"`
+	IntVal *int       `json:"intVal,omitempty"`
+	StrVal *string    `json:"stringVal,omitempty"`
+	MapVal []MapValue `json:"mapVal,omitempty"`
+}
+
+// MapValue is an entry of a map value.
+type MapValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		FpVal float64 `json:"fpVal"`
+	} `json:"value"`
+}
+
+// NutritionDataSource returns the data source definition for pushing servings.
+func NutritionDataSource(application string) DataSource {
+	return DataSource{
+		DataStreamName: "CronometerServings",
+		Type:           "raw",
+		Application:    Application{Name: application},
+		DataType: DataType{
+			Name: NutritionDataType,
+			Field: []Field{
+				{Name: "nutrients", Format: "map"},
+				{Name: "meal_type", Format: "integer", Optional: true},
+				{Name: "food_item", Format: "string", Optional: true},
+			},
+		},
+	}
+}
+
+// WeightDataSource returns the data source definition for pushing weight biometrics.
+func WeightDataSource(application string) DataSource {
+	return DataSource{
+		DataStreamName: "CronometerWeight",
+		Type:           "raw",
+		Application:    Application{Name: application},
+		DataType: DataType{
+			Name:  WeightDataType,
+			Field: []Field{{Name: "weight", Format: "floatPoint"}},
+		},
+	}
+}
+
+// nutrientKeys maps the keys of the com.google.nutrition nutrients map to the serving values in the units expected
+// by Google Fit.
+var nutrientKeys = []struct {
+	key   string
+	value func(s gocronometer.ServingRecord) float64
+}{
+	{"calories", func(s gocronometer.ServingRecord) float64 { return s.EnergyKcal }},
+	{"fat.total", func(s gocronometer.ServingRecord) float64 { return s.FatG }},
+	{"fat.saturated", func(s gocronometer.ServingRecord) float64 { return s.SaturatedG }},
+	{"fat.monounsaturated", func(s gocronometer.ServingRecord) float64 { return s.MonounsaturatedG }},
+	{"fat.polyunsaturated", func(s gocronometer.ServingRecord) float64 { return s.PolyunsaturatedG }},
+	{"fat.trans", func(s gocronometer.ServingRecord) float64 { return s.TransFatG }},
+	{"cholesterol", func(s gocronometer.ServingRecord) float64 { return s.CholesterolMg }},
+	{"sodium", func(s gocronometer.ServingRecord) float64 { return s.SodiumMg }},
+	{"potassium", func(s gocronometer.ServingRecord) float64 { return s.PotassiumMg }},
+	{"carbs.total", func(s gocronometer.ServingRecord) float64 { return s.CarbsG }},
+	{"dietary_fiber", func(s gocronometer.ServingRecord) float64 { return s.FiberG }},
+	{"sugar", func(s gocronometer.ServingRecord) float64 { return s.SugarsG }},
+	{"protein", func(s gocronometer.ServingRecord) float64 { return s.ProteinG }},
+	{"vitamin_a", func(s gocronometer.ServingRecord) float64 { return s.VitaminAIU() }},
+	{"vitamin_c", func(s gocronometer.ServingRecord) float64 { return s.VitaminCMg }},
+	{"calcium", func(s gocronometer.ServingRecord) float64 { return s.CalciumMg }},
+	{"iron", func(s gocronometer.ServingRecord) float64 { return s.IronMg }},
+}
+
+// MealType maps the Cronometer diary group to the Google Fit meal type.
+func MealType(group string) int {
+	switch strings.ToLower(strings.TrimSpace(group)) {
+	case "breakfast":
+		return MealTypeBreakfast
+	case "lunch":
+		return MealTypeLunch
+	case "dinner":
+		return MealTypeDinner
+	case "snacks", "snack":
+		return MealTypeSnack
+	default:
+		return MealTypeUnknown
+	}
+}
+
+// NutritionPoints converts each serving into a com.google.nutrition data point.
+func NutritionPoints(servings gocronometer.ServingRecords) []DataPoint {
+	points := make([]DataPoint, 0, len(servings))
+	for _, s := range servings {
+		nutrients := make([]MapValue, 0, len(nutrientKeys))
+		for _, k := range nutrientKeys {
+			v := k.value(s)
+			if v == 0 {
+				continue
+			}
+			entry := MapValue{Key: k.key}
+			entry.Value.FpVal = v
+			nutrients = append(nutrients, entry)
+		}
+
+		mealType := MealType(s.Group)
+		foodItem := s.FoodName
+		t := s.RecordedTime.UnixNano()
+		points = append(points, DataPoint{
+			DataTypeName:   NutritionDataType,
+			StartTimeNanos: t,
+			EndTimeNanos:   t,
+			Value:          []Value{{MapVal: nutrients}, {IntVal: &mealType}, {StrVal: &foodItem}},
+		})
+	}
+
+	return points
+}
+
+// kgPerPound is the number of kilograms in a pound.
+const kgPerPound = 0.45359237
+
+// WeightPoints converts the weight biometrics into com.google.weight data points in kilograms. Records of other
+// metrics or with an unknown unit are returned in skipped.
+func WeightPoints(records gocronometer.BiometricRecords) (points []DataPoint, skipped gocronometer.BiometricRecords) {
+	for _, b := range records {
+		if !strings.EqualFold(b.Metric, "weight") {
+			skipped = append(skipped, b)
+			continue
+		}
+
+		var kg float64
+		switch strings.ToLower(b.Unit) {
+		case "kg":
+			kg = b.Amount
+		case "lbs", "lb":
+			kg = b.Amount * kgPerPound
+		default:
+			skipped = append(skipped, b)
+			continue
+		}
+
+		t := b.RecordedTime.UnixNano()
+		points = append(points, DataPoint{
+			DataTypeName:   WeightDataType,
+			StartTimeNanos: t,
+			EndTimeNanos:   t,
+			Value:          []Value{{FpVal: &kg}},
+		})
+	}
+
+	return points, skipped
+}
+
+// Client pushes data to the Google Fit REST API.
+type Client struct {
+	// HTTPClient must add the OAuth 2.0 credentials to every request.
+	HTTPClient *http.Client
+
+	// BaseURL defaults to the BaseURL constant when empty.
+	BaseURL string
+}
+
+// NewClient creates a client using the authorized httpClient.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{HTTPClient: httpClient, BaseURL: BaseURL}
+}
+
+// CreateDataSource creates the data source and returns it with the DataStreamID assigned by Google Fit. If the data
+// source already exists Google Fit responds with a 409 status and an error is returned.
+func (c *Client) CreateDataSource(ctx context.Context, dataSource DataSource) (DataSource, error) {
+	var created DataSource
+	if err := c.do(ctx, "POST", "/dataSources", dataSource, &created); err != nil {
+		return DataSource{}, fmt.Errorf("creating data source: %s", err)
+	}
+
+	return created, nil
+}
+
+// PatchDataset adds the points to the data source. The dataset spans the earliest start and latest end of the points.
+func (c *Client) PatchDataset(ctx context.Context, dataSourceID string, points []DataPoint) error {
+	if len(points) == 0 {
+		return nil
+	}
+
+	dataset := Dataset{
+		DataSourceID:   dataSourceID,
+		MinStartTimeNs: points[0].StartTimeNanos,
+		MaxEndTimeNs:   points[0].EndTimeNanos,
+		Point:          points,
+	}
+	for _, p := range points {
+		if p.StartTimeNanos < dataset.MinStartTimeNs {
+			dataset.MinStartTimeNs = p.StartTimeNanos
+		}
+		if p.EndTimeNanos > dataset.MaxEndTimeNs {
+			dataset.MaxEndTimeNs = p.EndTimeNanos
+		}
+	}
+
+	path := fmt.Sprintf("/dataSources/%s/datasets/%d-%d", url.PathEscape(dataSourceID), dataset.MinStartTimeNs, dataset.MaxEndTimeNs)
+	if err := c.do(ctx, "PATCH", path, dataset, nil); err != nil {
+		return fmt.Errorf("patching dataset: %s", err)
+	}
+
+	return nil
+}
+
+// PushServings pushes the servings to the nutrition data source.
+func (c *Client) PushServings(ctx context.Context, dataSourceID string, servings gocronometer.ServingRecords) error {
+	return c.PatchDataset(ctx, dataSourceID, NutritionPoints(servings))
+}
+
+// PushWeights pushes the weight biometrics to the weight data source. Biometrics of other metrics are ignored.
+func (c *Client) PushWeights(ctx context.Context, dataSourceID string, records gocronometer.BiometricRecords) error {
+	points, _ := WeightPoints(records)
+	return c.PatchDataset(ctx, dataSourceID, points)
+}
+
+// do executes the JSON request and decodes the response into out if it is not nil.
+func (c *Client) do(ctx context.Context, method string, path string, in interface{}, out interface{}) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("encoding request: %s", err)
+	}
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = BaseURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %s", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("received non 2xx response of %d: body %s", resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("decoding response: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package googlefit_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/googlefit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_PushServings(t *testing.T) {
+	var received googlefit.Dataset
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("decoding dataset: %s", err)
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := googlefit.NewClient(server.Client())
+	client.BaseURL = server.URL
+
+	servings := gocronometer.ServingRecords{
+		{RecordedTime: time.Unix(100, 0), Group: "Breakfast", FoodName: "Eggs", EnergyKcal: 143, ProteinG: 12.6},
+		{RecordedTime: time.Unix(200, 0), Group: "Snacks", FoodName: "Apple", EnergyKcal: 95},
+	}
+
+	if err := client.PushServings(context.Background(), "raw:com.google.nutrition:1:CronometerServings", servings); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/dataSources/raw:com.google.nutrition:1:CronometerServings/datasets/100000000000-200000000000" {
+		t.Fatalf("unexpected request path %s", path)
+	}
+
+	if len(received.Point) != 2 {
+		t.Fatalf("expected 2 points but found %d", len(received.Point))
+	}
+
+	point := received.Point[0]
+	if len(point.Value[0].MapVal) != 2 || *point.Value[1].IntVal != googlefit.MealTypeBreakfast || *point.Value[2].StrVal != "Eggs" {
+		t.Fatalf("unexpected nutrition point: %+v", point)
+	}
+}
+
+func TestWeightPoints(t *testing.T) {
+	records := gocronometer.BiometricRecords{
+		{Metric: "Weight", Unit: "lbs", Amount: 100},
+		{Metric: "Heart Rate", Unit: "bpm", Amount: 60},
+	}
+
+	points, skipped := googlefit.WeightPoints(records)
+	if len(points) != 1 || len(skipped) != 1 {
+		t.Fatalf("expected one point and one skipped record")
+	}
+
+	if kg := *points[0].Value[0].FpVal; kg < 45.35 || kg > 45.36 {
+		t.Fatalf("expected 45.36 kg but found %f", kg)
+	}
+}
@@ -0,0 +1,17 @@
+package gocronometer
+
+// GroupBy groups the records of s by the key returned from key. The order of the records within each group matches the
+// order of s. It can be used with any of the record collections, for example grouping servings by food:
+//
+//	byFood := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) string {
+//		return s.FoodName
+//	})
+func GroupBy[S ~[]E, E any, K comparable](s S, key func(E) K) map[K]S {
+	groups := make(map[K]S)
+	for _, record := range s {
+		k := key(record)
+		groups[k] = append(groups[k], record)
+	}
+
+	return groups
+}
@@ -0,0 +1,30 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Eggs", Category: "Breakfast"},
+		{FoodName: "Toast", Category: "Breakfast"},
+		{FoodName: "Steak", Category: "Dinner"},
+	}
+
+	groups := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) string {
+		return s.Category
+	})
+
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups but found %d", len(groups))
+	}
+
+	breakfast := groups["Breakfast"]
+	if len(breakfast) != 2 || breakfast[0].FoodName != "Eggs" || breakfast[1].FoodName != "Toast" {
+		t.Fatalf("unexpected breakfast group: %+v", breakfast)
+	}
+
+	// The group retains the named collection type so its methods are available.
+	groups["Dinner"].SortByName()
+}
@@ -0,0 +1,72 @@
+package gocronometer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+)
+
+// gzipMagic are the first bytes of gzip compressed data.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompress returns a reader of the decompressed data if r is gzip compressed, detected by its leading bytes, or a
+// reader of the data unchanged otherwise.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil || !bytes.Equal(magic, gzipMagic) {
+		// Data too short to be compressed is passed on as is.
+		return br, nil
+	}
+
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading gzip header: %w", err)
+	}
+	return zr, nil
+}
+
+// decompressBytes decompresses the data if it is gzip compressed, otherwise it is returned unchanged.
+func decompressBytes(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+
+	r, err := decompress(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// exportFile is an opened export file which closes the file when closed.
+type exportFile struct {
+	io.Reader
+	file *os.File
+}
+
+// Close closes the file.
+func (e *exportFile) Close() error {
+	return e.file.Close()
+}
+
+// OpenExport opens an export stored in a file, such as servings.csv or servings.csv.gz. Compressed files are detected
+// by their content rather than their name and decompressed as they are read. The parsers also detect compressed
+// exports, so OpenExport is only needed when the CSV is read directly.
+func OpenExport(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening export: %w", err)
+	}
+
+	r, err := decompress(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("opening export %s: %w", path, err)
+	}
+
+	return &exportFile{Reader: r, file: f}, nil
+}
@@ -0,0 +1,87 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const gzipServings = "Day,Time,Food Name,Energy (kcal)\n2021-06-01,08:00,Eggs,140\n"
+
+func compress(t *testing.T, s string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseServings_Gzip(t *testing.T) {
+	servings, err := gocronometer.ParseServings(bytes.NewReader(compress(t, gzipServings)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(servings) != 1 || servings[0].FoodName != "Eggs" {
+		t.Fatalf("unexpected servings: %+v", servings)
+	}
+}
+
+func TestOpenExport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "servings.csv.gz")
+	if err := os.WriteFile(path, compress(t, gzipServings), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := gocronometer.OpenExport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != gzipServings {
+		t.Fatalf("expected the decompressed export but found %q", b)
+	}
+}
+
+func TestClient_ExportServings_Gzip(t *testing.T) {
+	client := gocronometer.NewClient(nil)
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := []byte(`//OK["token"]`)
+		if req.URL.Query().Get("generate") == "servings" {
+			body = compress(t, gzipServings)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Header:     http.Header{},
+			Request:    req,
+		}, nil
+	})
+
+	raw, err := client.ExportServings(context.Background(), time.Now(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(raw, "Day,Time") {
+		t.Fatalf("expected the decompressed export but found %q", raw)
+	}
+}
@@ -0,0 +1,81 @@
+package gocronometer
+
+import (
+	"sort"
+	"strings"
+)
+
+// LitersPerFluidOunce is the volume of a US fluid ounce in liters.
+const LitersPerFluidOunce = 0.0295735295625
+
+// fluidUnits maps the lower case units of fluid biometrics to liters per unit.
+var fluidUnits = map[string]float64{
+	"fl oz":  LitersPerFluidOunce,
+	"fl. oz": LitersPerFluidOunce,
+	"floz":   LitersPerFluidOunce,
+	"ml":     0.001,
+	"l":      1,
+}
+
+// Hydration is the water intake of a day.
+type Hydration struct {
+	Day Date
+
+	// FoodLiters is the water of the servings of the day, converted from grams at one kilogram per liter.
+	FoodLiters float64
+
+	// BiometricLiters is the fluid logged as biometrics on the day.
+	BiometricLiters float64
+
+	// TargetLiters is the target intake of the day. There is no target when it is zero.
+	TargetLiters float64
+}
+
+// Liters returns the total water intake of the day.
+func (h Hydration) Liters() float64 {
+	return h.FoodLiters + h.BiometricLiters
+}
+
+// Progress returns the fraction of the target reached, where 1 is the target. False is returned without a target.
+func (h Hydration) Progress() (float64, bool) {
+	if h.TargetLiters <= 0 {
+		return 0, false
+	}
+	return h.Liters() / h.TargetLiters, true
+}
+
+// DailyHydration returns the water intake of each day with water, earliest first, combining the water of the
+// servings with the biometrics logged in fluid units, such as fluid ounces or milliliters, normalized to liters. The
+// intake of each day is compared with targetLiters, which is ignored when zero.
+func DailyHydration(servings ServingRecords, biometrics BiometricRecords, targetLiters float64) []Hydration {
+	days := make(map[Date]*Hydration)
+	get := func(d Date) *Hydration {
+		if h, ok := days[d]; ok {
+			return h
+		}
+		h := &Hydration{Day: d, TargetLiters: targetLiters}
+		days[d] = h
+		return h
+	}
+
+	for _, s := range servings {
+		if s.WaterG != 0 {
+			get(s.Day).FoodLiters += s.WaterG / 1000
+		}
+	}
+	for _, b := range biometrics {
+		if liters, ok := fluidUnits[strings.ToLower(strings.TrimSpace(b.Unit))]; ok {
+			get(b.Day).BiometricLiters += b.Amount * liters
+		}
+	}
+
+	result := make([]Hydration, 0, len(days))
+	for _, h := range days {
+		result = append(result, *h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Day.Before(result[j].Day)
+	})
+
+	return result
+}
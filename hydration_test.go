@@ -0,0 +1,43 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDailyHydration(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: day, FoodName: "Water", WaterG: 500},
+		{Day: day, FoodName: "Apple", WaterG: 150},
+		{Day: day, FoodName: "Crackers"},
+		{Day: day.AddDays(1), FoodName: "Coffee", WaterG: 250},
+	}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: day, Metric: "Water", Unit: "fl oz", Amount: 16},
+		{Day: day.AddDays(2), Metric: "Water", Unit: "mL", Amount: 300},
+		{Day: day, Metric: "Weight", Unit: "kg", Amount: 80},
+	}
+
+	hydration := gocronometer.DailyHydration(servings, biometrics, 2)
+	if len(hydration) != 3 {
+		t.Fatalf("expected 3 days but found %+v", hydration)
+	}
+
+	first := hydration[0]
+	if first.Day != day || first.FoodLiters != 0.65 || math.Abs(first.BiometricLiters-0.473176473) > 1e-9 {
+		t.Fatalf("unexpected hydration of the first day: %+v", first)
+	}
+	if progress, ok := first.Progress(); !ok || math.Abs(progress-(0.65+0.473176473)/2) > 1e-9 {
+		t.Fatalf("unexpected progress of the first day: %f", progress)
+	}
+	if hydration[2].Liters() != 0.3 {
+		t.Fatalf("expected 0.3 liters on the last day but found %f", hydration[2].Liters())
+	}
+
+	if _, ok := gocronometer.DailyHydration(servings, nil, 0)[0].Progress(); ok {
+		t.Fatal("expected no progress without a target")
+	}
+}
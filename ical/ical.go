@@ -0,0 +1,200 @@
+// Package ical renders parsed Cronometer servings and exercises as iCalendar (RFC 5545) events so a food diary can
+// be overlaid on a calendar.
+package ical
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMealDuration is the length given to meal events.
+const DefaultMealDuration = 30 * time.Minute
+
+// The following are the formats of the event times.
+const (
+	dateTimeFormat = "20060102T150405Z"
+	dateFormat     = "20060102"
+)
+
+// Event is a calendar event. Events without a time of day are written as all-day events on Day.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Day         gocronometer.Date
+	AllDay      bool
+	Start       time.Time
+	End         time.Time
+}
+
+// MealEvents creates an event for each meal, made up of the servings of a group on a day. Timed meals start at the
+// earliest of their servings and last DefaultMealDuration. The description lists the foods and their energy. The
+// events are sorted by day and start time.
+func MealEvents(servings gocronometer.ServingRecords) []Event {
+	type mealKey struct {
+		day   gocronometer.Date
+		group string
+	}
+
+	meals := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) mealKey {
+		return mealKey{day: s.Day, group: s.Group}
+	})
+
+	events := make([]Event, 0, len(meals))
+	for key, meal := range meals {
+		event := Event{
+			Summary: fmt.Sprintf("%s (%s kcal)", mealName(key.group), formatFloat(meal.Total().EnergyKcal)),
+			Day:     key.day,
+			AllDay:  true,
+		}
+
+		var lines []string
+		for _, s := range meal {
+			lines = append(lines, fmt.Sprintf("%s, %s kcal", s.FoodName, formatFloat(s.EnergyKcal)))
+			if !s.HasTime {
+				continue
+			}
+			if event.AllDay || s.RecordedTime.Before(event.Start) {
+				event.AllDay = false
+				event.Start = s.RecordedTime
+			}
+		}
+		event.Description = strings.Join(lines, "\n")
+		if !event.AllDay {
+			event.End = event.Start.Add(DefaultMealDuration)
+		}
+		event.UID = uid("meal", key.day.String(), key.group)
+
+		events = append(events, event)
+	}
+
+	sortEvents(events)
+	return events
+}
+
+// ExerciseEvents creates an event for each exercise, lasting the minutes of the exercise. The events are sorted by
+// day and start time.
+func ExerciseEvents(exercises gocronometer.ExerciseRecords) []Event {
+	events := make([]Event, 0, len(exercises))
+	for i, e := range exercises {
+		event := Event{
+			Summary: fmt.Sprintf("%s (%s kcal)", e.Exercise, formatFloat(e.CaloriesBurned)),
+			Description: fmt.Sprintf("%s minutes, %s kcal burned",
+				formatFloat(e.Minutes), formatFloat(e.CaloriesBurned)),
+			Day:    e.Day,
+			AllDay: !e.HasTime,
+			UID:    uid("exercise", e.Day.String(), e.Exercise, strconv.Itoa(i)),
+		}
+		if e.HasTime {
+			event.Start = e.RecordedTime
+			event.End = e.RecordedTime.Add(time.Duration(e.Minutes * float64(time.Minute)))
+		}
+
+		events = append(events, event)
+	}
+
+	sortEvents(events)
+	return events
+}
+
+// mealName names the meal of a group, as servings without a group are not in a meal.
+func mealName(group string) string {
+	if group == "" {
+		return "Uncategorized"
+	}
+	return group
+}
+
+// sortEvents sorts the events by day and then start time, with all-day events first.
+func sortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Day != events[j].Day {
+			return events[i].Day.Before(events[j].Day)
+		}
+		if events[i].AllDay != events[j].AllDay {
+			return events[i].AllDay
+		}
+		return events[i].Start.Before(events[j].Start)
+	})
+}
+
+// uid creates a stable identifier for an event so repeated exports update the same calendar events.
+func uid(parts ...string) string {
+	sum := sha1.Sum([]byte(strings.Join(parts, "\x00")))
+	return fmt.Sprintf("%x@gocronometer", sum[:10])
+}
+
+// formatFloat formats amounts without trailing zeros.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Write writes the events to w as an iCalendar document. The stamp is recorded as the DTSTAMP of every event and is
+// normally the current time.
+func Write(w io.Writer, events []Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:-//burke//gocronometer//EN")
+	writeLine(bw, "CALSCALE:GREGORIAN")
+
+	for _, e := range events {
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+e.UID)
+		writeLine(bw, "DTSTAMP:"+stamp.UTC().Format(dateTimeFormat))
+		if e.AllDay {
+			writeLine(bw, "DTSTART;VALUE=DATE:"+e.Day.Time(time.UTC).Format(dateFormat))
+			writeLine(bw, "DTEND;VALUE=DATE:"+e.Day.AddDays(1).Time(time.UTC).Format(dateFormat))
+		} else {
+			writeLine(bw, "DTSTART:"+e.Start.UTC().Format(dateTimeFormat))
+			writeLine(bw, "DTEND:"+e.End.UTC().Format(dateTimeFormat))
+		}
+		writeLine(bw, "SUMMARY:"+escapeText(e.Summary))
+		if e.Description != "" {
+			writeLine(bw, "DESCRIPTION:"+escapeText(e.Description))
+		}
+		writeLine(bw, "END:VEVENT")
+	}
+
+	writeLine(bw, "END:VCALENDAR")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing calendar: %s", err)
+	}
+	return nil
+}
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// maxLineOctets is the longest a content line may be before it is folded.
+const maxLineOctets = 75
+
+// writeLine writes a content line, folding it onto continuation lines so no line is longer than maxLineOctets.
+// Lines are only folded between characters so multi-byte characters are not split. Errors are reported by Flush.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	n := 0
+	for _, r := range line {
+		size := len(string(r))
+		if n+size > limit {
+			w.WriteString("\r\n ")
+			// The leading space of a continuation line counts towards its length.
+			limit = maxLineOctets - 1
+			n = 0
+		}
+		w.WriteRune(r)
+		n += size
+	}
+	w.WriteString("\r\n")
+}
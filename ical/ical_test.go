@@ -0,0 +1,75 @@
+package ical_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/ical"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMealEvents(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: day, HasTime: true, RecordedTime: time.Date(2021, 6, 1, 8, 10, 0, 0, time.UTC), Group: "Breakfast",
+			FoodName: "Toast", EnergyKcal: 150},
+		{Day: day, HasTime: true, RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), Group: "Breakfast",
+			FoodName: "Eggs", EnergyKcal: 140},
+		{Day: day, Group: "Snacks", FoodName: "Apple", EnergyKcal: 95},
+	}
+
+	events := ical.MealEvents(servings)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events but found %+v", events)
+	}
+
+	if !events[0].AllDay || events[0].Summary != "Snacks (95 kcal)" {
+		t.Fatalf("expected the untimed snack first but found %+v", events[0])
+	}
+
+	breakfast := events[1]
+	if breakfast.Summary != "Breakfast (290 kcal)" || !breakfast.Start.Equal(time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)) ||
+		breakfast.End.Sub(breakfast.Start) != ical.DefaultMealDuration {
+		t.Fatalf("unexpected breakfast event: %+v", breakfast)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	exercises := gocronometer.ExerciseRecords{
+		{Day: gocronometer.Date{Year: 2021, Month: time.June, Day: 1}, HasTime: true,
+			RecordedTime: time.Date(2021, 6, 1, 18, 0, 0, 0, time.UTC), Exercise: "Running, 10 km/h", Minutes: 45,
+			CaloriesBurned: 500},
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, ical.ExerciseEvents(exercises), time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTART:20210601T180000Z\r\n",
+		"DTEND:20210601T184500Z\r\n",
+		`SUMMARY:Running\, 10 km/h (500 kcal)` + "\r\n",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected the calendar to contain %q but found %q", expected, buf.String())
+		}
+	}
+}
+
+func TestWrite_Folding(t *testing.T) {
+	events := []ical.Event{{UID: "1", Summary: strings.Repeat("a", 200), AllDay: true}}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, events, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Fatalf("expected lines to be folded but found %q", line)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Package decimal parses the plain decimal numbers of the Cronometer exports faster than strconv.ParseFloat. Numbers
+// such as "12.5" or "-0.25" that are exactly convertible are parsed directly from the string or byte slice without
+// copying it, while anything else is left to strconv.
+package decimal
+
+// pow10 are the powers of ten exactly representable as a float64.
+var pow10 = [...]float64{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16,
+	1e17, 1e18, 1e19, 1e20, 1e21, 1e22}
+
+// maxMantissa is the largest integer exactly representable as a float64.
+const maxMantissa = 1 << 53
+
+// Parse parses s, an optional sign followed by digits with an optional fractional part separated by the decimal
+// separator, such as "-1234.5". False is returned when s has any other form, such as an exponent, spaces or grouping
+// separators, or when it has too many digits to be converted exactly, in which case it should be parsed by
+// strconv.ParseFloat. The value returned is the same as strconv.ParseFloat would return.
+func Parse[S ~string | ~[]byte](s S, decimal byte) (float64, bool) {
+	i := 0
+	negative := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		i++
+	}
+
+	var mantissa uint64
+	digits, fraction := 0, -1
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			// Twenty digits may overflow the mantissa, which is then too large regardless.
+			if digits++; digits > 19 {
+				return 0, false
+			}
+			mantissa = mantissa*10 + uint64(c-'0')
+			if fraction >= 0 {
+				fraction++
+			}
+		case c == decimal && fraction < 0:
+			fraction = 0
+		default:
+			return 0, false
+		}
+	}
+
+	if digits == 0 || mantissa > maxMantissa || fraction >= len(pow10) {
+		return 0, false
+	}
+
+	// Both the mantissa and the power of ten are exact, so the division is correctly rounded.
+	f := float64(mantissa)
+	if fraction > 0 {
+		f /= pow10[fraction]
+	}
+	if negative {
+		f = -f
+	}
+	return f, true
+}
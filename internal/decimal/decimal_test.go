@@ -0,0 +1,99 @@
+package decimal_test
+
+import (
+	"github.com/burke/gocronometer/internal/decimal"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected float64
+		ok       bool
+	}{
+		{"0", 0, true},
+		{"12.5", 12.5, true},
+		{"-0.25", -0.25, true},
+		{"+3", 3, true},
+		{"5.", 5, true},
+		{".5", 0.5, true},
+		{"0.1", 0.1, true},
+		{"9007199254740992", 9007199254740992, true},
+		{"", 0, false},
+		{"-", 0, false},
+		{".", 0, false},
+		{"1.2.3", 0, false},
+		{"1e3", 0, false},
+		{" 1", 0, false},
+		{"1,234", 0, false},
+		{"NaN", 0, false},
+		{"9007199254740993", 0, false},
+		{"12345678901234567890", 0, false},
+		{"0.00000000000000000000001", 0, false},
+	}
+
+	for _, c := range cases {
+		f, ok := decimal.Parse(c.s, '.')
+		if ok != c.ok || f != c.expected {
+			t.Fatalf("expected %q to parse as %v, %t but found %v, %t", c.s, c.expected, c.ok, f, ok)
+		}
+	}
+
+	if f, ok := decimal.Parse([]byte("1,5"), ','); !ok || f != 1.5 {
+		t.Fatalf("expected a comma decimal separator to parse as 1.5 but found %v, %t", f, ok)
+	}
+
+	if f, ok := decimal.Parse("-0", '.'); !ok || !math.Signbit(f) {
+		t.Fatalf("expected negative zero but found %v, %t", f, ok)
+	}
+}
+
+func TestParse_MatchesStrconv(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		var s string
+		switch i % 3 {
+		case 0:
+			s = strconv.FormatFloat(r.Float64()*math.Pow(10, float64(r.Intn(12))), 'f', r.Intn(8), 64)
+		case 1:
+			s = strconv.FormatFloat(-r.Float64()*1000, 'f', -1, 64)
+		default:
+			s = strconv.Itoa(r.Intn(1000)) + "." + strings.Repeat("0", r.Intn(5)) + strconv.Itoa(r.Intn(100000))
+		}
+
+		f, ok := decimal.Parse(s, '.')
+		if !ok {
+			continue
+		}
+		expected, err := strconv.ParseFloat(s, 64)
+		if err != nil || f != expected {
+			t.Fatalf("expected %q to parse as %v but found %v", s, expected, f)
+		}
+	}
+}
+
+var benchmarkValues = []string{"0", "12.5", "143", "0.04", "1688.25", "72.333333", "-3.2", "2300"}
+
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, v := range benchmarkValues {
+			if _, ok := decimal.Parse(v, '.'); !ok {
+				b.Fatal(v)
+			}
+		}
+	}
+}
+
+func BenchmarkParseFloat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, v := range benchmarkValues {
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package gocronometer
+
+import (
+	"iter"
+	"slices"
+	"sort"
+)
+
+// All returns an iterator over the servings in order.
+func (s ServingRecords) All() iter.Seq[ServingRecord] {
+	return slices.Values(s)
+}
+
+// ByDay returns an iterator over the servings of each day, earliest day first. The servings of a day retain their
+// order.
+//
+//	for day, servings := range servings.ByDay() {
+//		fmt.Printf("%s: %.0f kcal\n", day, servings.Total().EnergyKcal)
+//	}
+func (s ServingRecords) ByDay() iter.Seq2[Date, ServingRecords] {
+	return byDay(s, func(s ServingRecord) Date { return s.Day })
+}
+
+// All returns an iterator over the exercises in order.
+func (e ExerciseRecords) All() iter.Seq[ExerciseRecord] {
+	return slices.Values(e)
+}
+
+// ByDay returns an iterator over the exercises of each day, earliest day first. The exercises of a day retain their
+// order.
+func (e ExerciseRecords) ByDay() iter.Seq2[Date, ExerciseRecords] {
+	return byDay(e, func(e ExerciseRecord) Date { return e.Day })
+}
+
+// All returns an iterator over the biometrics in order.
+func (b BiometricRecords) All() iter.Seq[BiometricRecord] {
+	return slices.Values(b)
+}
+
+// ByDay returns an iterator over the biometrics of each day, earliest day first. The biometrics of a day retain their
+// order.
+func (b BiometricRecords) ByDay() iter.Seq2[Date, BiometricRecords] {
+	return byDay(b, func(b BiometricRecord) Date { return b.Day })
+}
+
+// ByMetric returns an iterator over the biometrics of each metric, sorted by metric. The biometrics of a metric retain
+// their order.
+func (b BiometricRecords) ByMetric() iter.Seq2[string, BiometricRecords] {
+	return sortedGroups(b, func(b BiometricRecord) string { return b.Metric }, func(a string, b string) bool {
+		return a < b
+	})
+}
+
+// byDay returns an iterator over the records of s grouped by the day returned from day, earliest day first.
+func byDay[S ~[]E, E any](s S, day func(E) Date) iter.Seq2[Date, S] {
+	return sortedGroups(s, day, Date.Before)
+}
+
+// sortedGroups returns an iterator over the records of s grouped by GroupBy with the key returned from key, in the
+// order of the keys sorted by less. The records are grouped when the iteration starts.
+func sortedGroups[S ~[]E, E any, K comparable](s S, key func(E) K, less func(K, K) bool) iter.Seq2[K, S] {
+	return func(yield func(K, S) bool) {
+		groups := GroupBy(s, key)
+		keys := make([]K, 0, len(groups))
+		for k := range groups {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return less(keys[i], keys[j])
+		})
+
+		for _, k := range keys {
+			if !yield(k, groups[k]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestServingRecords_ByDay(t *testing.T) {
+	june1 := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	june2 := june1.AddDays(1)
+	servings := gocronometer.ServingRecords{
+		{Day: june2, FoodName: "Oats", EnergyKcal: 150},
+		{Day: june1, FoodName: "Eggs", EnergyKcal: 143},
+		{Day: june2, FoodName: "Milk", EnergyKcal: 100},
+	}
+
+	var days []gocronometer.Date
+	var energy []float64
+	for day, s := range servings.ByDay() {
+		days = append(days, day)
+		energy = append(energy, s.Total().EnergyKcal)
+	}
+
+	if len(days) != 2 || days[0] != june1 || days[1] != june2 || energy[0] != 143 || energy[1] != 250 {
+		t.Fatalf("unexpected days %v with energy %v", days, energy)
+	}
+
+	// Stopping early ends the iteration.
+	for range servings.ByDay() {
+		break
+	}
+
+	var names []string
+	for s := range servings.All() {
+		names = append(names, s.FoodName)
+	}
+	if len(names) != 3 || names[0] != "Oats" {
+		t.Fatalf("expected the servings in order but found %v", names)
+	}
+}
+
+func TestBiometricRecords_ByMetric(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: day, Metric: "Weight", Amount: 80},
+		{Day: day, Metric: "Blood Glucose", Amount: 5.2},
+		{Day: day.AddDays(1), Metric: "Weight", Amount: 79.5},
+	}
+
+	var metrics []string
+	for metric, records := range biometrics.ByMetric() {
+		metrics = append(metrics, metric)
+		if metric == "Weight" && (len(records) != 2 || records[1].Amount != 79.5) {
+			t.Fatalf("unexpected weights %+v", records)
+		}
+	}
+
+	if len(metrics) != 2 || metrics[0] != "Blood Glucose" || metrics[1] != "Weight" {
+		t.Fatalf("expected the metrics sorted but found %v", metrics)
+	}
+
+	count := 0
+	for _, records := range biometrics.ByDay() {
+		count += len(records)
+	}
+	if count != 3 {
+		t.Fatalf("expected every biometric to be in a day but found %d", count)
+	}
+}
@@ -0,0 +1,137 @@
+package gocronometer
+
+import (
+	"sort"
+	"strings"
+)
+
+// The following are the values used by KetoAnalysis for the zero values of KetoOptions.
+const (
+	// DefaultNetCarbsCeilingG is the net carbs in grams a day commonly recommended for a ketogenic diet.
+	DefaultNetCarbsCeilingG = 20
+
+	// DefaultKetosisThresholdMmol is the blood ketone level in mmol/L from which nutritional ketosis is considered.
+	DefaultKetosisThresholdMmol = 0.5
+)
+
+// MgPerDLPerMmolKetones converts beta-hydroxybutyrate from mmol/L to mg/dL.
+const MgPerDLPerMmolKetones = 10.41
+
+// KetoOptions configures KetoAnalysis. The zero value uses the defaults.
+type KetoOptions struct {
+	// NetCarbsCeilingG is the most net carbs in grams a day may have. DefaultNetCarbsCeilingG is used when zero.
+	NetCarbsCeilingG float64
+
+	// KetosisThresholdMmol is the ketone level in mmol/L from which a day is in ketosis. DefaultKetosisThresholdMmol
+	// is used when zero.
+	KetosisThresholdMmol float64
+}
+
+// KetoDay is the analysis of a day of a ketogenic or low-carb diet.
+type KetoDay struct {
+	Day Date
+
+	// NetCarbsG is the sum of ComputeNetCarbsG of the servings of the day.
+	NetCarbsG float64
+
+	// UnderCeiling is true when NetCarbsG does not exceed the ceiling of the options.
+	UnderCeiling bool
+
+	// FatPercent, ProteinPercent and CarbsPercent are the shares of the energy of the fat, protein and carbs of the
+	// day, as calculated by MacroEnergy, from 0 to 100. Alcohol is excluded.
+	FatPercent     float64
+	ProteinPercent float64
+	CarbsPercent   float64
+
+	// KetogenicRatio is the grams of fat to the grams of protein and net carbs, where a classic ketogenic diet is
+	// 4:1. It is zero when the day has no protein nor net carbs.
+	KetogenicRatio float64
+
+	// KetonesMmol is the highest ketone reading of the day in mmol/L. It is zero when HasKetones is false.
+	KetonesMmol float64
+	HasKetones  bool
+
+	// InKetosis is true when the ketones of the day reach the threshold of the options. It is false without ketones.
+	InKetosis bool
+}
+
+// KetoAnalysis analyzes each day with servings or ketone biometrics, sorted by day. Ketones are biometrics whose
+// metric contains "ketone", in mmol/L or mg/dL. Other biometrics are ignored.
+func KetoAnalysis(servings ServingRecords, biometrics BiometricRecords, opts KetoOptions) []KetoDay {
+	if opts.NetCarbsCeilingG == 0 {
+		opts.NetCarbsCeilingG = DefaultNetCarbsCeilingG
+	}
+	if opts.KetosisThresholdMmol == 0 {
+		opts.KetosisThresholdMmol = DefaultKetosisThresholdMmol
+	}
+
+	days := make(map[Date]*KetoDay)
+	get := func(d Date) *KetoDay {
+		if k, ok := days[d]; ok {
+			return k
+		}
+		k := &KetoDay{Day: d}
+		days[d] = k
+		return k
+	}
+
+	for day, servings := range GroupBy(servings, func(s ServingRecord) Date { return s.Day }) {
+		k := get(day)
+		var proteinG, fatG float64
+		for _, s := range servings {
+			k.NetCarbsG += s.ComputeNetCarbsG()
+			proteinG += s.ProteinG
+			fatG += s.FatG
+		}
+
+		energy := servings.MacroEnergy()
+		if total := energy.ProteinKcal + energy.CarbsKcal + energy.FatKcal; total > 0 {
+			k.FatPercent = energy.FatKcal / total * 100
+			k.ProteinPercent = energy.ProteinKcal / total * 100
+			k.CarbsPercent = energy.CarbsKcal / total * 100
+		}
+		if proteinG+k.NetCarbsG > 0 {
+			k.KetogenicRatio = fatG / (proteinG + k.NetCarbsG)
+		}
+	}
+
+	for _, b := range biometrics {
+		mmol, ok := ketonesMmol(b)
+		if !ok {
+			continue
+		}
+		k := get(b.Day)
+		if !k.HasKetones || mmol > k.KetonesMmol {
+			k.KetonesMmol = mmol
+		}
+		k.HasKetones = true
+	}
+
+	result := make([]KetoDay, 0, len(days))
+	for _, k := range days {
+		k.UnderCeiling = k.NetCarbsG <= opts.NetCarbsCeilingG
+		k.InKetosis = k.HasKetones && k.KetonesMmol >= opts.KetosisThresholdMmol
+		result = append(result, *k)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Day.Before(result[j].Day)
+	})
+
+	return result
+}
+
+// ketonesMmol returns the ketones of the biometric in mmol/L. False is returned for other biometrics.
+func ketonesMmol(b BiometricRecord) (float64, bool) {
+	if !strings.Contains(strings.ToLower(b.Metric), "ketone") {
+		return 0, false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(b.Unit)) {
+	case "mmol/l", "":
+		return b.Amount, true
+	case "mg/dl":
+		return b.Amount / MgPerDLPerMmolKetones, true
+	default:
+		return 0, false
+	}
+}
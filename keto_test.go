@@ -0,0 +1,46 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestKetoAnalysis(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: day, ProteinG: 20, FatG: 70, CarbsG: 15, FiberG: 5},
+		{Day: day, ProteinG: 20, FatG: 50, CarbsG: 10, FiberG: 0},
+		{Day: day.AddDays(1), ProteinG: 30, FatG: 40, CarbsG: 80, FiberG: 10},
+	}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: day, Metric: "Blood Ketones", Unit: "mmol/L", Amount: 0.4},
+		{Day: day, Metric: "Blood Ketones", Unit: "mmol/L", Amount: 1.2},
+		{Day: day.AddDays(1), Metric: "Blood Ketones", Unit: "mg/dL", Amount: 2},
+		{Day: day.AddDays(2), Metric: "Weight", Unit: "kg", Amount: 80},
+	}
+
+	days := gocronometer.KetoAnalysis(servings, biometrics, gocronometer.KetoOptions{})
+	if len(days) != 2 {
+		t.Fatalf("expected 2 days but found %+v", days)
+	}
+
+	first := days[0]
+	if first.Day != day || first.NetCarbsG != 20 || !first.UnderCeiling || !first.InKetosis || first.KetonesMmol != 1.2 {
+		t.Fatalf("unexpected first day: %+v", first)
+	}
+	if first.KetogenicRatio != 2 || math.Abs(first.FatPercent+first.ProteinPercent+first.CarbsPercent-100) > 1e-9 {
+		t.Fatalf("unexpected macros of the first day: %+v", first)
+	}
+
+	second := days[1]
+	if second.NetCarbsG != 70 || second.UnderCeiling || !second.HasKetones || second.InKetosis {
+		t.Fatalf("unexpected second day: %+v", second)
+	}
+
+	days = gocronometer.KetoAnalysis(servings, nil, gocronometer.KetoOptions{NetCarbsCeilingG: 100})
+	if !days[1].UnderCeiling || days[0].HasKetones || days[0].InKetosis {
+		t.Fatalf("unexpected days with a custom ceiling: %+v", days)
+	}
+}
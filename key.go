@@ -0,0 +1,38 @@
+package gocronometer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Key returns a stable identifier of the serving for use as the primary key of stores, deduplication and incremental
+// syncs. It is the SHA-256 of the day, time, group, food and amount of the serving, so it does not change when the
+// nutrient values of a food are revised. The time is the time of day shown in Cronometer, taken from RecordedTime in
+// its own location, so the key only depends on the location of the records for records with a time. The same food
+// logged twice with the same amount at the same time has the same key, so callers should count keys rather than
+// assume they are unique.
+func (s ServingRecord) Key() string {
+	return recordKey("serving", s.Day.String(), formatTime(s.RecordedTime, s.HasTime), s.Group, s.FoodName, s.Source,
+		s.FoodID, formatFloat(s.QuantityValue), s.QuantityUnits)
+}
+
+// Key returns a stable identifier of the exercise. It is the SHA-256 of the day, time, exercise, minutes and calories
+// burned, and otherwise behaves as ServingRecord.Key.
+func (e ExerciseRecord) Key() string {
+	return recordKey("exercise", e.Day.String(), formatTime(e.RecordedTime, e.HasTime), e.Exercise,
+		formatFloat(e.Minutes), formatFloat(e.CaloriesBurned))
+}
+
+// Key returns a stable identifier of the biometric. It is the SHA-256 of the day, time, metric, unit and amount, and
+// otherwise behaves as ServingRecord.Key.
+func (b BiometricRecord) Key() string {
+	return recordKey("biometric", b.Day.String(), formatTime(b.RecordedTime, b.HasTime), b.Metric, b.Unit,
+		formatFloat(b.Amount))
+}
+
+// recordKey hashes the fields separated by the ASCII unit separator, which is not found in the exports.
+func recordKey(fields ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
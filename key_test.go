@@ -0,0 +1,58 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServingRecord_Key(t *testing.T) {
+	raw := "Day,Time,Group,Food Name,Amount,Energy (kcal)\n2021-06-01,08:00,Breakfast,Eggs,2 large,140\n"
+	midnight, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	noon, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithDefaultTime(gocronometer.DefaultTimeNoon))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := midnight[0].Key()
+	if len(key) != 64 || key != noon[0].Key() {
+		t.Fatalf("expected the same key for the same serving but found %s and %s", key, noon[0].Key())
+	}
+
+	revised := midnight[0]
+	revised.EnergyKcal = 150
+	if revised.Key() != key {
+		t.Fatal("expected the key to ignore the nutrient values")
+	}
+
+	changed := midnight[0]
+	changed.QuantityValue = 3
+	if changed.Key() == key {
+		t.Fatal("expected the key to change with the amount")
+	}
+}
+
+func TestBiometricRecord_Key(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	untimed := gocronometer.BiometricRecord{Day: day, RecordedTime: day.Time(time.UTC), Metric: "Weight", Unit: "kg", Amount: 80}
+	defaulted := untimed
+	defaulted.RecordedTime = defaulted.RecordedTime.Add(gocronometer.DefaultTimeNoon)
+	if untimed.Key() != defaulted.Key() {
+		t.Fatal("expected the default time to be ignored")
+	}
+
+	timed := defaulted
+	timed.HasTime = true
+	if timed.Key() == untimed.Key() {
+		t.Fatal("expected the time to change the key")
+	}
+
+	exercise := gocronometer.ExerciseRecord{Day: day, RecordedTime: day.Time(time.UTC), Exercise: "Weight", Minutes: 80}
+	if exercise.Key() == untimed.Key() {
+		t.Fatal("expected records of different types to have different keys")
+	}
+}
@@ -0,0 +1,184 @@
+package gocronometer
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// LazyServingRecord is a serving whose numeric columns, the amount and the nutrients, are kept as the raw strings of
+// the export and only converted when accessed. Pipelines filtering servings by day or food before the numeric work
+// avoid converting the nutrients of the servings discarded. The conversion is cached on the record, so it is not safe
+// for concurrent use.
+type LazyServingRecord struct {
+	RecordedTime time.Time
+	Day          Date
+	HasTime      bool
+	Raw          *RawRow
+	Group        string
+	FoodName     string
+	Source       string
+	FoodID       string
+	Category     string
+
+	row     int
+	values  []string
+	layout  *lazyLayout
+	serving *ServingRecord
+}
+
+type LazyServingRecords []LazyServingRecord
+
+// lazyLayout holds the numeric columns of a servings export shared by its lazy records.
+type lazyLayout struct {
+	opts     *ParseOptions
+	headers  []string
+	parsers  []columnParser[ServingRecord]
+	byHeader map[string]int
+}
+
+// ParseServingsLazy parses the raw CSV servings export configured by the options provided, deferring the conversion
+// of the numeric columns to the access of each record. Values that fail to convert are reported when accessed rather
+// than by the parse.
+func ParseServingsLazy(rawCSVReader io.Reader, options ...ParseOption) (LazyServingRecords, error) {
+	opts := newParseOptions(options...)
+	layout := &lazyLayout{opts: opts, byHeader: make(map[string]int)}
+
+	return parseExport(rawCSVReader, opts, layout.column,
+		func(serving *LazyServingRecord, info rowInfo) {
+			serving.Day, serving.HasTime, serving.RecordedTime, serving.Raw = info.day, info.hasTime, info.recordedTime, info.raw
+			serving.row, serving.layout = info.row, layout
+		})
+}
+
+// column returns the parser of the lazy servings column with the header. The text columns are set as they are parsed
+// while the values of the other columns, including those with a ServingColumnHandler, are kept for their conversion on
+// access.
+func (l *lazyLayout) column(header string) columnParser[LazyServingRecord] {
+	if _, ok := l.opts.ServingColumnHandlers[header]; ok {
+		return l.deferred(header)
+	}
+
+	switch header {
+	case "Group":
+		return func(serving *LazyServingRecord, v string) error {
+			serving.Group = v
+			return nil
+		}
+	case "Food Name":
+		return func(serving *LazyServingRecord, v string) error {
+			serving.FoodName = v
+			return nil
+		}
+	case "Source":
+		return func(serving *LazyServingRecord, v string) error {
+			serving.Source = v
+			return nil
+		}
+	case "Food ID":
+		return func(serving *LazyServingRecord, v string) error {
+			serving.FoodID = v
+			return nil
+		}
+	case "Category":
+		return func(serving *LazyServingRecord, v string) error {
+			serving.Category = v
+			return nil
+		}
+	}
+
+	return l.deferred(header)
+}
+
+// deferred returns the parser keeping the value of the column with the header, or nil for unknown columns.
+func (l *lazyLayout) deferred(header string) columnParser[LazyServingRecord] {
+	parser := l.opts.servingColumn(header)
+	if parser == nil {
+		return nil
+	}
+
+	i := len(l.headers)
+	l.headers = append(l.headers, header)
+	l.parsers = append(l.parsers, parser)
+	l.byHeader[header] = i
+	return func(serving *LazyServingRecord, v string) error {
+		// The layout is complete once the headers are read, before the first row.
+		if serving.values == nil {
+			serving.values = make([]string, len(l.headers))
+		}
+		serving.values[i] = v
+		return nil
+	}
+}
+
+// Serving converts the serving to a ServingRecord, converting every numeric column. The conversion is done on the
+// first call and returned by the later calls.
+func (l *LazyServingRecord) Serving() (ServingRecord, error) {
+	if l.serving != nil {
+		return *l.serving, nil
+	}
+
+	serving := ServingRecord{
+		RecordedTime: l.RecordedTime,
+		Day:          l.Day,
+		HasTime:      l.HasTime,
+		Raw:          l.Raw,
+		Group:        l.Group,
+		FoodName:     l.FoodName,
+		Source:       l.Source,
+		FoodID:       l.FoodID,
+		Category:     l.Category,
+	}
+	if l.layout != nil {
+		for i, v := range l.values {
+			if err := l.layout.parsers[i](&serving, v); err != nil {
+				return ServingRecord{}, &RowError{Row: l.row, Column: l.layout.headers[i], Value: v, Err: err}
+			}
+		}
+	}
+
+	l.serving = &serving
+	return serving, nil
+}
+
+// Nutrient converts the amount of the named nutrient, as found by LookupNutrient, in its Unit. Only the column of the
+// nutrient is converted unless the serving was already converted by Serving. Nutrients missing from the export are
+// zero.
+func (l *LazyServingRecord) Nutrient(name string) (float64, error) {
+	n, ok := LookupNutrient(name)
+	if !ok {
+		return 0, fmt.Errorf("unknown nutrient %q", name)
+	}
+
+	if l.serving != nil {
+		return n.Value(*l.serving), nil
+	}
+	if l.layout == nil {
+		return 0, nil
+	}
+
+	i, ok := l.layout.byHeader[n.Header]
+	if !ok || i >= len(l.values) {
+		return 0, nil
+	}
+
+	var serving ServingRecord
+	if err := l.layout.parsers[i](&serving, l.values[i]); err != nil {
+		return 0, &RowError{Row: l.row, Column: n.Header, Value: l.values[i], Err: err}
+	}
+	return n.Value(serving), nil
+}
+
+// Servings converts every serving, returning the first error.
+func (l LazyServingRecords) Servings() (ServingRecords, error) {
+	servings := make(ServingRecords, 0, len(l))
+	for i := range l {
+		serving, err := l[i].Serving()
+		if err != nil {
+			return nil, err
+		}
+		servings = append(servings, serving)
+	}
+
+	return servings, nil
+}
@@ -0,0 +1,107 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/burke/gocronometer"
+)
+
+func TestParseServingsLazy(t *testing.T) {
+	raw := "Day,Time,Group,Food Name,Amount,Energy (kcal),Protein (g),Fat (g)\n" +
+		"2021-06-01,08:00,Breakfast,Eggs,2 large,143,12.6,9.5\n" +
+		"2021-06-02,12:00,Lunch,Spinach,1 cup,7,0.9,lots\n"
+
+	lazy, err := gocronometer.ParseServingsLazy(strings.NewReader(raw))
+	if err != nil {
+		t.Fatalf("expected invalid nutrients to not fail the parse: %s", err)
+	}
+
+	if len(lazy) != 2 || lazy[0].FoodName != "Eggs" || lazy[0].Group != "Breakfast" || !lazy[0].HasTime {
+		t.Fatalf("unexpected lazy servings: %+v", lazy)
+	}
+
+	protein, err := lazy[0].Nutrient("Protein")
+	if err != nil || protein != 12.6 {
+		t.Fatalf("expected protein of 12.6 but found %f: %v", protein, err)
+	}
+
+	serving, err := lazy[0].Serving()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serving.FoodName != "Eggs" || serving.QuantityValue != 2 || serving.EnergyKcal != 143 || serving.FatG != 9.5 {
+		t.Fatalf("unexpected serving values: %+v", serving)
+	}
+
+	// The invalid value is only reported once the nutrient is accessed.
+	if energy, err := lazy[1].Nutrient("Energy"); err != nil || energy != 7 {
+		t.Fatalf("expected energy of 7 but found %f: %v", energy, err)
+	}
+
+	var rowErr *gocronometer.RowError
+	if _, err := lazy[1].Nutrient("Fat"); !errors.As(err, &rowErr) || rowErr.Row != 3 || rowErr.Column != "Fat (g)" {
+		t.Fatalf("expected a row error of the fat column but found %v", err)
+	}
+
+	if _, err := lazy.Servings(); err == nil {
+		t.Fatalf("expected converting every serving to fail")
+	}
+
+	if _, err := lazy[0].Nutrient("Unobtainium"); err == nil {
+		t.Fatalf("expected an error for an unknown nutrient")
+	}
+
+	if zinc, err := lazy[0].Nutrient("Zinc"); err != nil || zinc != 0 {
+		t.Fatalf("expected a nutrient missing from the export to be zero but found %f: %v", zinc, err)
+	}
+}
+
+func TestParseServingsLazy_MatchesParseServings(t *testing.T) {
+	export := benchmarkServings(t)
+
+	eager, err := gocronometer.ParseServings(bytes.NewReader(export))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lazy, err := gocronometer.ParseServingsLazy(bytes.NewReader(export))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	servings, err := lazy.Servings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !servings.Equal(eager) {
+		t.Fatalf("expected the lazy servings to match the servings parsed eagerly")
+	}
+}
+
+func BenchmarkParseServingsLazy_Filtered(b *testing.B) {
+	export := benchmarkServings(b)
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		lazy, err := gocronometer.ParseServingsLazy(bytes.NewReader(export))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for j := range lazy {
+			if lazy[j].Day.Month != time.June {
+				continue
+			}
+			if _, err := lazy[j].Serving(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package gocronometer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrLimitExceeded is returned, wrapped, by the parsers when an export exceeds ParseOptions.MaxBytes, MaxRecords or
+// MaxFieldLength. Exceeding a limit aborts the parse even in lenient mode.
+var ErrLimitExceeded = errors.New("parse limit exceeded")
+
+// limitReader returns the reader failing with ErrLimitExceeded once more than MaxBytes are read from it or a field of
+// the CSV read from it is longer than MaxFieldLength, or the reader itself without limits. Fields are limited as they
+// are read so a long field is never buffered whole by the reader of the rows.
+func (o *ParseOptions) limitReader(r io.Reader) io.Reader {
+	if o.MaxBytes > 0 {
+		r = &limitedReader{r: r, remaining: o.MaxBytes, max: o.MaxBytes}
+	}
+	if o.MaxFieldLength > 0 {
+		r = &fieldLimitReader{r: r, max: o.MaxFieldLength, line: 1}
+	}
+	return r
+}
+
+// checkRow returns an error when the row, the line number lineNum, exceeds MaxRecords or MaxFieldLength. The lengths
+// of the fields are checked again once read, as the carriage returns the fieldLimitReader does not count can be part
+// of a field.
+func (o *ParseOptions) checkRow(row []string, lineNum int) error {
+	if o.MaxRecords > 0 && lineNum-1 > o.MaxRecords {
+		return fmt.Errorf("more than %d records: %w", o.MaxRecords, ErrLimitExceeded)
+	}
+	if o.MaxFieldLength > 0 {
+		for _, v := range row {
+			if len(v) > o.MaxFieldLength {
+				return fmt.Errorf("row %d has a field longer than %d bytes: %w", lineNum, o.MaxFieldLength, ErrLimitExceeded)
+			}
+		}
+	}
+	return nil
+}
+
+// limitedReader is an io.Reader failing with ErrLimitExceeded once more than max bytes are read.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	max       int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, fmt.Errorf("more than %d bytes: %w", l.max, ErrLimitExceeded)
+	}
+
+	// Reading one byte past the limit tells an export of exactly max bytes apart from a larger one.
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return 0, fmt.Errorf("more than %d bytes: %w", l.max, ErrLimitExceeded)
+	}
+	return n, err
+}
+
+// fieldLimitReader is an io.Reader failing with ErrLimitExceeded once a field of the CSV read through it is longer
+// than max bytes. The quotes enclosing a field and escaping the quotes within it are not counted, nor are carriage
+// returns, so no field accepted by checkRow is rejected.
+type fieldLimitReader struct {
+	r   io.Reader
+	max int
+	err error
+
+	line   int
+	length int
+	state  fieldState
+}
+
+// fieldState is the position of a fieldLimitReader within the field being read.
+type fieldState int
+
+const (
+	// fieldStart is the start of a field, where a quote opens a quoted field.
+	fieldStart fieldState = iota
+
+	// unquotedField is within a field not enclosed in quotes.
+	unquotedField
+
+	// quotedField is within a field enclosed in quotes, where commas and line endings are part of the field.
+	quotedField
+
+	// quotedFieldQuote follows a quote within a quoted field, which either escapes a following quote or closes the
+	// field.
+	quotedFieldQuote
+)
+
+func (f *fieldLimitReader) Read(p []byte) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	n, err := f.r.Read(p)
+	for i, c := range p[:n] {
+		if !f.scan(c) {
+			f.err = fmt.Errorf("line %d has a field longer than %d bytes: %w", f.line, f.max, ErrLimitExceeded)
+			return i, f.err
+		}
+	}
+	return n, err
+}
+
+// scan advances the reader past the byte, returning false when the field it belongs to is longer than max bytes.
+func (f *fieldLimitReader) scan(c byte) bool {
+	if f.state == quotedFieldQuote {
+		if c == '"' {
+			f.state = quotedField
+			f.length++
+			return f.length <= f.max
+		}
+		f.state = unquotedField
+	}
+
+	switch {
+	case f.state == quotedField:
+		if c == '"' {
+			f.state = quotedFieldQuote
+			return true
+		}
+		if c == '\n' {
+			f.line++
+		}
+	case c == ',':
+		f.state, f.length = fieldStart, 0
+		return true
+	case c == '\n':
+		f.state, f.length = fieldStart, 0
+		f.line++
+		return true
+	case c == '"' && f.state == fieldStart:
+		f.state = quotedField
+		return true
+	default:
+		f.state = unquotedField
+	}
+
+	if c == '\r' {
+		return true
+	}
+	f.length++
+	return f.length <= f.max
+}
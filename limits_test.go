@@ -0,0 +1,65 @@
+package gocronometer_test
+
+import (
+	"errors"
+	"github.com/burke/gocronometer"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLimits(t *testing.T) {
+	export := "Day,Time,Food Name,Energy (kcal)\n2021-06-01,08:00,Eggs,140\n2021-06-01,12:00,Soup,210\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(export),
+		gocronometer.WithMaxBytes(int64(len(export))), gocronometer.WithMaxRecords(2), gocronometer.WithMaxFieldLength(32))
+	if err != nil || len(servings) != 2 {
+		t.Fatalf("expected the export within the limits to parse but found %+v, %v", servings, err)
+	}
+
+	for name, option := range map[string]gocronometer.ParseOption{
+		"bytes":   gocronometer.WithMaxBytes(int64(len(export) - 1)),
+		"records": gocronometer.WithMaxRecords(1),
+		"field":   gocronometer.WithMaxFieldLength(3),
+	} {
+		_, err := gocronometer.ParseServings(strings.NewReader(export), option, gocronometer.WithLenient())
+		if !errors.Is(err, gocronometer.ErrLimitExceeded) {
+			t.Fatalf("expected the %s limit to be exceeded but found %v", name, err)
+		}
+	}
+
+	if _, err := gocronometer.Parse(strings.NewReader(export), time.UTC, gocronometer.WithMaxBytes(10)); !errors.Is(err, gocronometer.ErrLimitExceeded) {
+		t.Fatalf("expected Parse to enforce the byte limit but found %v", err)
+	}
+}
+
+// endlessField is a reader of a field that never ends.
+type endlessField struct{}
+
+func (endlessField) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'a'
+	}
+	return len(p), nil
+}
+
+func TestParseLimits_FieldLength(t *testing.T) {
+	// The field would never end, so the limit must be enforced as it is read rather than once it is parsed.
+	for name, options := range map[string][]gocronometer.ParseOption{
+		"csv":     {gocronometer.WithMaxFieldLength(1024)},
+		"fastcsv": {gocronometer.WithMaxFieldLength(1024), gocronometer.WithFastCSV()},
+	} {
+		export := io.MultiReader(strings.NewReader("Day,Time,Food Name\n2021-06-01,08:00,"), endlessField{})
+		if _, err := gocronometer.ParseServings(export, options...); !errors.Is(err, gocronometer.ErrLimitExceeded) {
+			t.Fatalf("expected the %s field limit to be exceeded but found %v", name, err)
+		}
+	}
+
+	// The quotes enclosing and escaping a field are not part of its length, nor is the line ending.
+	export := "Day,Time,Food Name\r\n2021-06-01,08:00,\"Mac \"\"n\"\" Cheese, Boxed\"\r\n"
+	servings, err := gocronometer.ParseServings(strings.NewReader(export), gocronometer.WithMaxFieldLength(len(`Mac "n" Cheese, Boxed`)))
+	if err != nil || len(servings) != 1 || servings[0].FoodName != `Mac "n" Cheese, Boxed` {
+		t.Fatalf("expected the field within the limit to parse but found %+v, %v", servings, err)
+	}
+}
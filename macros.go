@@ -0,0 +1,97 @@
+package gocronometer
+
+// The following are the Atwater general factors, the energy in kcal provided by a gram of each macronutrient.
+const (
+	ProteinKcalPerGram = 4
+	CarbsKcalPerGram   = 4
+	FatKcalPerGram     = 9
+	AlcoholKcalPerGram = 7
+)
+
+// MacroEnergy is the energy in kcal provided by each of the macronutrients.
+type MacroEnergy struct {
+	ProteinKcal float64
+	CarbsKcal   float64
+	FatKcal     float64
+	AlcoholKcal float64
+}
+
+// Total returns the sum of the energy provided by the macronutrients.
+func (m MacroEnergy) Total() float64 {
+	return m.ProteinKcal + m.CarbsKcal + m.FatKcal + m.AlcoholKcal
+}
+
+// MacroEnergy calculates the energy provided by each macronutrient of the serving. Alcohol is included as it provides
+// energy that is otherwise unaccounted for when comparing the macronutrients to EnergyKcal.
+func (s ServingRecord) MacroEnergy() MacroEnergy {
+	return MacroEnergy{
+		ProteinKcal: s.ProteinG * ProteinKcalPerGram,
+		CarbsKcal:   s.CarbsG * CarbsKcalPerGram,
+		FatKcal:     s.FatG * FatKcalPerGram,
+		AlcoholKcal: s.AlcoholG * AlcoholKcalPerGram,
+	}
+}
+
+// MacroEnergy calculates the energy provided by each macronutrient across all the servings.
+func (s ServingRecords) MacroEnergy() MacroEnergy {
+	var total MacroEnergy
+	for _, serving := range s {
+		m := serving.MacroEnergy()
+		total.ProteinKcal += m.ProteinKcal
+		total.CarbsKcal += m.CarbsKcal
+		total.FatKcal += m.FatKcal
+		total.AlcoholKcal += m.AlcoholKcal
+	}
+
+	return total
+}
+
+// The following are the tolerances used by CheckMacroEnergy when no options are given. The general factors differ
+// from the specific factors used for many foods, so small differences are expected.
+const (
+	DefaultMacroEnergyAbsoluteTolerance = 10
+	DefaultMacroEnergyRelativeTolerance = 0.15
+)
+
+// MacroEnergyIssue is a serving whose energy disagrees with the energy of its macronutrients.
+type MacroEnergyIssue struct {
+	// Index is the index of the serving in the servings checked.
+	Index int
+
+	Serving        ServingRecord
+	EnergyKcal     float64
+	MacroKcal      float64
+	DifferenceKcal float64
+}
+
+// CheckMacroEnergy returns the servings whose EnergyKcal differs from the energy of their macronutrients, as
+// calculated by MacroEnergy, beyond the tolerances of the options. Large differences usually indicate a custom food
+// with broken values. When no options are given, differences within DefaultMacroEnergyAbsoluteTolerance or
+// DefaultMacroEnergyRelativeTolerance are accepted.
+func (s ServingRecords) CheckMacroEnergy(options ...EqualOption) []MacroEnergyIssue {
+	if len(options) == 0 {
+		options = []EqualOption{
+			WithAbsoluteTolerance(DefaultMacroEnergyAbsoluteTolerance),
+			WithRelativeTolerance(DefaultMacroEnergyRelativeTolerance),
+		}
+	}
+	opts := newEqualOptions(options...)
+
+	issues := make([]MacroEnergyIssue, 0)
+	for i, serving := range s {
+		macro := serving.MacroEnergy().Total()
+		if opts.floatEqual(serving.EnergyKcal, macro) {
+			continue
+		}
+
+		issues = append(issues, MacroEnergyIssue{
+			Index:          i,
+			Serving:        serving,
+			EnergyKcal:     serving.EnergyKcal,
+			MacroKcal:      macro,
+			DifferenceKcal: serving.EnergyKcal - macro,
+		})
+	}
+
+	return issues
+}
@@ -0,0 +1,52 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestServingRecords_MacroEnergy(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Energy (kcal),Protein (g),Carbs (g),Fat (g),Alcohol (g)\n" +
+		"2021-06-01,18:00,Beer,1 bottle,153,1.6,12.6,0,13.9\n" +
+		"2021-06-01,18:00,Peanuts,1 oz,161,7.3,4.6,14,0\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if servings[0].AlcoholG != 13.9 {
+		t.Fatalf("expected alcohol of 13.9 but found %f", servings[0].AlcoholG)
+	}
+
+	m := servings.MacroEnergy()
+	if m.AlcoholKcal < 97.29 || m.AlcoholKcal > 97.31 {
+		t.Fatalf("expected alcohol energy of 97.3 but found %f", m.AlcoholKcal)
+	}
+
+	if total := m.Total(); total < 327.69 || total > 327.71 {
+		t.Fatalf("expected total macro energy of 327.7 but found %f", total)
+	}
+}
+
+func TestServingRecords_CheckMacroEnergy(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Eggs", EnergyKcal: 143, ProteinG: 12.6, CarbsG: 0.7, FatG: 9.5},
+		{FoodName: "Wine", EnergyKcal: 125, CarbsG: 4, AlcoholG: 15.6},
+		{FoodName: "Broken Custom Food", EnergyKcal: 50, ProteinG: 20, CarbsG: 30, FatG: 10},
+		{FoodName: "Gum", EnergyKcal: 5, CarbsG: 2},
+	}
+
+	issues := servings.CheckMacroEnergy()
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue but found %+v", issues)
+	}
+	if issues[0].Index != 2 || issues[0].MacroKcal != 290 || issues[0].DifferenceKcal != -240 {
+		t.Fatalf("unexpected issue: %+v", issues[0])
+	}
+
+	if issues := servings.CheckMacroEnergy(gocronometer.WithAbsoluteTolerance(1)); len(issues) != 3 {
+		t.Fatalf("expected 3 issues with a tolerance of 1 kcal but found %+v", issues)
+	}
+}
@@ -0,0 +1,51 @@
+package gocronometer
+
+// MergeServings combines multiple servings exports into a single collection sorted by time. Records found in more
+// than one export, such as when exports with overlapping date ranges are combined while backfilling, are only included
+// once. A record that appears several times within a single export, such as the same food logged twice in a day, is
+// kept as many times as it appears in that export.
+func MergeServings(exports ...ServingRecords) ServingRecords {
+	return mergeSorted(exports)
+}
+
+// MergeExercises combines multiple exercise exports into a single collection sorted by time. Duplicates are handled
+// in the same way as MergeServings.
+func MergeExercises(exports ...ExerciseRecords) ExerciseRecords {
+	return mergeSorted(exports)
+}
+
+// MergeBiometrics combines multiple biometric exports into a single collection sorted by time. Duplicates are handled
+// in the same way as MergeServings.
+func MergeBiometrics(exports ...BiometricRecords) BiometricRecords {
+	return mergeSorted(exports)
+}
+
+// mergeSorted merges the exports with mergeRecords and sorts the result by time.
+func mergeSorted[S ~[]T, T Record](exports []S) S {
+	merged := mergeRecords(exports, func(record T) any { return record.mergeKey() })
+	sortByTime(merged)
+
+	return merged
+}
+
+// mergeRecords concatenates the exports while removing records that are duplicated across exports. Each distinct key
+// is kept as many times as it appears in the export containing the most occurrences of it. The order of the first
+// occurrences is retained.
+func mergeRecords[S ~[]E, E any, K comparable](exports []S, key func(E) K) S {
+	kept := make(map[K]int)
+	merged := make(S, 0)
+
+	for _, export := range exports {
+		seen := make(map[K]int)
+		for _, record := range export {
+			k := key(record)
+			seen[k]++
+			if seen[k] > kept[k] {
+				kept[k]++
+				merged = append(merged, record)
+			}
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,34 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestMergeServings(t *testing.T) {
+	day1 := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+	day3 := time.Date(2021, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	first := gocronometer.ServingRecords{
+		{RecordedTime: day1, FoodName: "Eggs"},
+		{RecordedTime: day2, FoodName: "Eggs"},
+		{RecordedTime: day2, FoodName: "Eggs"},
+	}
+	second := gocronometer.ServingRecords{
+		{RecordedTime: day3, FoodName: "Toast"},
+		{RecordedTime: day2, FoodName: "Eggs"},
+		{RecordedTime: day2, FoodName: "Eggs"},
+	}
+
+	merged := gocronometer.MergeServings(first, second)
+
+	if len(merged) != 4 {
+		t.Fatalf("expected 4 servings but found %d: %+v", len(merged), merged)
+	}
+
+	if !merged[0].RecordedTime.Equal(day1) || !merged[3].RecordedTime.Equal(day3) {
+		t.Fatalf("merged servings were not sorted by time: %+v", merged)
+	}
+}
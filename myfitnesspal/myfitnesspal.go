@@ -0,0 +1,61 @@
+// Package myfitnesspal imports MyFitnessPal exports into the gocronometer record types so historical MyFitnessPal
+// data can be analyzed and merged with Cronometer data.
+package myfitnesspal
+
+import (
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+)
+
+// Source is the value given to ServingRecord.Source for imported servings.
+const Source = "MyFitnessPal"
+
+// headerMapping maps the MyFitnessPal nutrition export headers to the Cronometer servings export headers.
+var headerMapping = map[string]string{
+	"Date":                "Day",
+	"Time":                "Time",
+	"Meal":                "Group",
+	"Calories":            "Energy (kcal)",
+	"Fat (g)":             "Fat (g)",
+	"Saturated Fat":       "Saturated (g)",
+	"Polyunsaturated Fat": "Polyunsaturated (g)",
+	"Monounsaturated Fat": "Monounsaturated (g)",
+	"Trans Fat":           "Trans-Fats (g)",
+	"Cholesterol":         "Cholesterol (mg)",
+	"Sodium (mg)":         "Sodium (mg)",
+	"Potassium":           "Potassium (mg)",
+	"Carbohydrates (g)":   "Carbs (g)",
+	"Fiber":               "Fiber (g)",
+	"Sugar":               "Sugars (g)",
+	"Protein (g)":         "Protein (g)",
+}
+
+// ignoredColumns are the MyFitnessPal columns with no equivalent. The vitamins and minerals are exported as a
+// percentage of a daily value that is not included in the export, so they cannot be converted to amounts.
+var ignoredColumns = []string{"Vitamin A", "Vitamin C", "Calcium", "Iron", "Note"}
+
+// ParseNutritionExport parses the MyFitnessPal "Nutrition" CSV export. MyFitnessPal exports totals per meal rather
+// than per food, so each row becomes a serving with the meal as both the Group and the FoodName. The options are
+// applied to the underlying gocronometer parser, allowing the location and other options to be set.
+func ParseNutritionExport(rawCSVReader io.Reader, options ...gocronometer.ParseOption) (gocronometer.ServingRecords, error) {
+	opts := []gocronometer.ParseOption{gocronometer.WithHeaderMapping(headerMapping)}
+	for _, column := range ignoredColumns {
+		opts = append(opts, gocronometer.WithServingColumnHandler(column, func(*gocronometer.ServingRecord, string) error {
+			return nil
+		}))
+	}
+	opts = append(opts, options...)
+
+	servings, err := gocronometer.ParseServings(rawCSVReader, opts...)
+	if err != nil && servings == nil {
+		return nil, fmt.Errorf("parsing myfitnesspal export: %w", err)
+	}
+
+	for i := range servings {
+		servings[i].FoodName = servings[i].Group
+		servings[i].Source = Source
+	}
+
+	return servings, err
+}
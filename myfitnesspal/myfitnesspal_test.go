@@ -0,0 +1,33 @@
+package myfitnesspal_test
+
+import (
+	"github.com/burke/gocronometer/myfitnesspal"
+	"strings"
+	"testing"
+)
+
+func TestParseNutritionExport(t *testing.T) {
+	raw := "Date,Meal,Calories,Fat (g),Saturated Fat,Polyunsaturated Fat,Monounsaturated Fat,Trans Fat,Cholesterol," +
+		"Sodium (mg),Potassium,Carbohydrates (g),Fiber,Sugar,Protein (g),Vitamin A,Vitamin C,Calcium,Iron,Note\n" +
+		"2019-01-02,Breakfast,\"1,020.5\",30,10,5,12,0,370,\"1,200\",400,120,8,20,45,10,5,20,15,\n" +
+		"2019-01-02,Dinner,600,20,5,3,9,0,80,900,700,60,10,6,40,0,30,10,20,leftovers\n"
+
+	servings, err := myfitnesspal.ParseNutritionExport(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(servings) != 2 {
+		t.Fatalf("expected 2 servings but found %d", len(servings))
+	}
+
+	s := servings[0]
+	if s.FoodName != "Breakfast" || s.Source != myfitnesspal.Source || s.EnergyKcal != 1020.5 || s.SodiumMg != 1200 ||
+		s.CarbsG != 120 || s.SugarsG != 20 {
+		t.Fatalf("unexpected serving: %+v", s)
+	}
+
+	if s.Day.String() != "2019-01-02" || s.HasTime {
+		t.Fatalf("unexpected serving day: %s", s.Day)
+	}
+}
@@ -0,0 +1,35 @@
+package gocronometer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteServingsNDJSON writes the servings as newline-delimited JSON, one object per line, for piping into tools such
+// as jq or the load jobs of data warehouses.
+func WriteServingsNDJSON(w io.Writer, servings ServingRecords) error {
+	return writeNDJSON(w, servings)
+}
+
+// WriteExerciseNDJSON writes the exercises as newline-delimited JSON, one object per line.
+func WriteExerciseNDJSON(w io.Writer, exercises ExerciseRecords) error {
+	return writeNDJSON(w, exercises)
+}
+
+// WriteBiometricsNDJSON writes the biometrics as newline-delimited JSON, one object per line.
+func WriteBiometricsNDJSON(w io.Writer, biometrics BiometricRecords) error {
+	return writeNDJSON(w, biometrics)
+}
+
+// writeNDJSON encodes each record on its own line. The encoder terminates every value with a newline.
+func writeNDJSON[S ~[]E, E any](w io.Writer, records S) error {
+	enc := json.NewEncoder(w)
+	for i, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("writing record %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
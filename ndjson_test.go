@@ -0,0 +1,54 @@
+package gocronometer_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestWriteServingsNDJSON(t *testing.T) {
+	servings, err := gocronometer.ParseServings(strings.NewReader("Day,Time,Group,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,08:00,Breakfast,Eggs,2 large,140\n2021-06-01,12:00,Lunch,Rice,1 cup,200\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := gocronometer.WriteServingsNDJSON(&buf, servings); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded gocronometer.ServingRecords
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var s gocronometer.ServingRecord
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf("line %q is not a JSON object: %s", scanner.Text(), err)
+		}
+		decoded = append(decoded, s)
+	}
+
+	if !decoded.Equal(servings) {
+		t.Fatalf("expected %+v but found %+v", servings, decoded)
+	}
+}
+
+func TestWriteBiometricsNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := gocronometer.WriteBiometricsNDJSON(&buf, gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}, {Metric: "Heart Rate", Amount: 62}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 2 {
+		t.Fatalf("expected 2 lines but found %d", lines)
+	}
+
+	buf.Reset()
+	if err := gocronometer.WriteExerciseNDJSON(&buf, nil); err != nil || buf.Len() != 0 {
+		t.Fatalf("expected no output for no exercises but found %q, %v", buf.String(), err)
+	}
+}
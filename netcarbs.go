@@ -0,0 +1,56 @@
+package gocronometer
+
+import (
+	"math"
+)
+
+// ComputeNetCarbsG recomputes the net carbs of the serving as its carbs less fiber and sugar alcohols. Net carbs are
+// never negative, as custom foods can have more fiber than carbs.
+func (s ServingRecord) ComputeNetCarbsG() float64 {
+	return math.Max(0, s.CarbsG-s.FiberG-s.SugarAlcoholsG)
+}
+
+// RecomputeNetCarbs replaces the exported net carbs of each serving with ComputeNetCarbsG.
+func (s ServingRecords) RecomputeNetCarbs() {
+	for i := range s {
+		s[i].NetCarbsG = s[i].ComputeNetCarbsG()
+	}
+}
+
+// NetCarbsIssue is a serving whose exported net carbs disagree with the recomputed net carbs.
+type NetCarbsIssue struct {
+	// Index is the index of the serving in the servings checked.
+	Index int
+
+	Serving     ServingRecord
+	ExportedG   float64
+	ComputedG   float64
+	DifferenceG float64
+}
+
+// CheckNetCarbs returns the servings whose exported net carbs differ from the recomputed net carbs by more than
+// tolerance grams, which usually indicates a custom food with inconsistent values. Cronometer only subtracts sugar
+// alcohols when enabled in the settings of the account, so net carbs matching either carbs less fiber or carbs less
+// fiber and sugar alcohols are accepted.
+func (s ServingRecords) CheckNetCarbs(tolerance float64) []NetCarbsIssue {
+	issues := make([]NetCarbsIssue, 0)
+	for i, serving := range s {
+		computed := serving.ComputeNetCarbsG()
+		lessFiberOnly := math.Max(0, serving.CarbsG-serving.FiberG)
+
+		difference := serving.NetCarbsG - computed
+		if math.Abs(difference) <= tolerance || math.Abs(serving.NetCarbsG-lessFiberOnly) <= tolerance {
+			continue
+		}
+
+		issues = append(issues, NetCarbsIssue{
+			Index:       i,
+			Serving:     serving,
+			ExportedG:   serving.NetCarbsG,
+			ComputedG:   computed,
+			DifferenceG: difference,
+		})
+	}
+
+	return issues
+}
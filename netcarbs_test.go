@@ -0,0 +1,32 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestServingRecords_CheckNetCarbs(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Apple", CarbsG: 25, FiberG: 4.4, NetCarbsG: 20.6},
+		{FoodName: "Protein Bar", CarbsG: 24, FiberG: 14, SugarAlcoholsG: 6, NetCarbsG: 4},
+		{FoodName: "Chocolate", CarbsG: 24, FiberG: 14, SugarAlcoholsG: 6, NetCarbsG: 10},
+		{FoodName: "Custom Bread", CarbsG: 40, FiberG: 5, NetCarbsG: 20},
+		{FoodName: "Fiber Supplement", CarbsG: 5, FiberG: 6, NetCarbsG: 0},
+	}
+
+	issues := servings.CheckNetCarbs(0.1)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue but found %+v", issues)
+	}
+	if issues[0].Index != 3 || issues[0].ExportedG != 20 || issues[0].ComputedG != 35 || issues[0].DifferenceG != -15 {
+		t.Fatalf("unexpected issue: %+v", issues[0])
+	}
+
+	servings.RecomputeNetCarbs()
+	if servings[2].NetCarbsG != 4 || servings[3].NetCarbsG != 35 || servings[4].NetCarbsG != 0 {
+		t.Fatalf("unexpected net carbs: %+v", servings)
+	}
+	if issues := servings.CheckNetCarbs(0); len(issues) != 0 {
+		t.Fatalf("expected no issues after recomputing but found %+v", issues)
+	}
+}
@@ -0,0 +1,66 @@
+package gocronometer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// NoteRecord is a note from the notes export.
+type NoteRecord struct {
+	RecordedTime time.Time
+	Day          Date
+	HasTime      bool
+	Raw          *RawRow
+	Group        string
+	Note         string
+}
+
+type NoteRecords []NoteRecord
+
+// ParseNotes parses the raw CSV notes export configured by the options provided.
+func ParseNotes(rawCSVReader io.Reader, options ...ParseOption) (NoteRecords, error) {
+	opts := newParseOptions(options...)
+
+	return parseExport(rawCSVReader, opts, noteColumn,
+		func(note *NoteRecord, info rowInfo) {
+			note.Day, note.HasTime, note.RecordedTime, note.Raw = info.day, info.hasTime, info.recordedTime, info.raw
+		})
+}
+
+// noteColumn returns the parser of the notes column with the header, or nil for unknown columns.
+func noteColumn(header string) columnParser[NoteRecord] {
+	switch header {
+	case "Group":
+		return func(note *NoteRecord, v string) error {
+			note.Group = v
+			return nil
+		}
+	case "Note":
+		return func(note *NoteRecord, v string) error {
+			note.Note = v
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// ExportNotesParsedWithLocation exports the notes within the date range and parses them into a go struct. Only the
+// YYYY-mm-dd is utilized of startDate and endDate. The export is parsed and dates set to the location provided.
+func (c *Client) ExportNotesParsedWithLocation(ctx context.Context, startDate time.Time, endDate time.Time, location *time.Location) (NoteRecords, error) {
+	raw, err := c.ExportNotes(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("retreiving raw data: %w", err)
+	}
+
+	notes, err := ParseNotes(strings.NewReader(raw), WithLocation(location))
+	if err != nil {
+		return nil, fmt.Errorf("parsing raw data: %w", err)
+	}
+	c.debug(ctx, "parsed export", "export", "notes", "records", len(notes), "bytes", len(raw))
+
+	return notes, nil
+}
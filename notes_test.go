@@ -0,0 +1,22 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestParseNotes(t *testing.T) {
+	raw := "Day,Group,Note\n" +
+		"2021-06-01,Breakfast,\"Felt great, slept well\"\n"
+
+	notes, err := gocronometer.ParseNotes(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(notes) != 1 || notes[0].Group != "Breakfast" || notes[0].Note != "Felt great, slept well" ||
+		notes[0].Day.String() != "2021-06-01" {
+		t.Fatalf("unexpected notes: %+v", notes)
+	}
+}
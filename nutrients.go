@@ -0,0 +1,206 @@
+package gocronometer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Unit is the unit of a nutrient value.
+type Unit string
+
+// The following are the units used by Cronometer exports.
+const (
+	UnitKcal      Unit = "kcal"
+	UnitGram      Unit = "g"
+	UnitMilligram Unit = "mg"
+	UnitMicrogram Unit = "µg"
+	UnitIU        Unit = "IU"
+)
+
+// gramsPerUnit is the number of grams in one of each mass unit.
+var gramsPerUnit = map[Unit]float64{
+	UnitGram:      1,
+	UnitMilligram: 1e-3,
+	UnitMicrogram: 1e-6,
+}
+
+// Quantity is a value with its unit.
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// String returns the quantity formatted as it appears in the export headers, for example "12.5 mg".
+func (q Quantity) String() string {
+	return fmt.Sprintf("%g %s", q.Value, q.Unit)
+}
+
+// In converts the quantity to the unit provided. Only conversions between the mass units are supported, converting to
+// any other unit returns an error unless the quantity is already in that unit. Conversions between IU and mass depend
+// on the vitamin and are found in the conversions package.
+func (q Quantity) In(unit Unit) (Quantity, error) {
+	if q.Unit == unit {
+		return q, nil
+	}
+
+	from, fromOK := gramsPerUnit[q.Unit]
+	to, toOK := gramsPerUnit[unit]
+	if !fromOK || !toOK {
+		return Quantity{}, fmt.Errorf("cannot convert %s to %s", q.Unit, unit)
+	}
+
+	return Quantity{Value: q.Value * from / to, Unit: unit}, nil
+}
+
+// Nutrient describes a nutrient column of the servings export and the ServingRecord field it is parsed into. The
+// unit of the nutrient is the unit of the column, so the value of the field is always in Unit regardless of the
+// suffix of the field name.
+type Nutrient struct {
+	// Name is the name of the nutrient as shown in Cronometer, for example "Vitamin K".
+	Name string
+
+	// Header is the header of the column in the export, for example "Vitamin K (µg)".
+	Header string
+
+	// Unit is the unit of the nutrient values.
+	Unit Unit
+
+	field func(s *ServingRecord) *float64
+}
+
+// Value returns the amount of the nutrient in the serving in the unit of the nutrient.
+func (n Nutrient) Value(s ServingRecord) float64 {
+	return *n.field(&s)
+}
+
+// Quantity returns the amount of the nutrient in the serving with its unit.
+func (n Nutrient) Quantity(s ServingRecord) Quantity {
+	return Quantity{Value: n.Value(s), Unit: n.Unit}
+}
+
+// Set sets the amount of the nutrient in the serving, given in the unit of the nutrient. The deprecated fields are
+// kept in sync for compatibility.
+func (n Nutrient) Set(s *ServingRecord, v float64) {
+	*n.field(s) = v
+	s.B12Mg, s.VitaminKMg = s.B12Ug, s.VitaminKUg
+}
+
+// nutrientsByHeader indexes the nutrients by the header of their column.
+var nutrientsByHeader = func() map[string]Nutrient {
+	byHeader := make(map[string]Nutrient, len(nutrients))
+	for _, n := range nutrients {
+		byHeader[n.Header] = n
+	}
+	return byHeader
+}()
+
+// nutrients contains every nutrient column of the servings export in the order they appear.
+var nutrients = []Nutrient{
+	{Name: "Energy", Header: "Energy (kcal)", Unit: UnitKcal, field: func(s *ServingRecord) *float64 { return &s.EnergyKcal }},
+	{Name: "Caffeine", Header: "Caffeine (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.CaffeineMg }},
+	{Name: "Water", Header: "Water (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.WaterG }},
+	{Name: "B1 (Thiamine)", Header: "B1 (Thiamine) (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.B1Mg }},
+	{Name: "B2 (Riboflavin)", Header: "B2 (Riboflavin) (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.B2Mg }},
+	{Name: "B3 (Niacin)", Header: "B3 (Niacin) (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.B3Mg }},
+	{Name: "B5 (Pantothenic Acid)", Header: "B5 (Pantothenic Acid) (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.B5Mg }},
+	{Name: "B6 (Pyridoxine)", Header: "B6 (Pyridoxine) (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.B6Mg }},
+	{Name: "B12 (Cobalamin)", Header: "B12 (Cobalamin) (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.B12Ug }},
+	{Name: "Biotin", Header: "Biotin (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.BiotinUg }},
+	{Name: "Choline", Header: "Choline (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.CholineMg }},
+	{Name: "Folate", Header: "Folate (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.FolateUg }},
+	{Name: "Vitamin A", Header: "Vitamin A (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.VitaminAUg }},
+	{Name: "Vitamin C", Header: "Vitamin C (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.VitaminCMg }},
+	{Name: "Vitamin D", Header: "Vitamin D (IU)", Unit: UnitIU, field: func(s *ServingRecord) *float64 { return &s.VitaminDUI }},
+	{Name: "Vitamin E", Header: "Vitamin E (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.VitaminEMg }},
+	{Name: "Vitamin K", Header: "Vitamin K (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.VitaminKUg }},
+	{Name: "Calcium", Header: "Calcium (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.CalciumMg }},
+	{Name: "Chromium", Header: "Chromium (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.ChromiumUg }},
+	{Name: "Copper", Header: "Copper (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.CopperMg }},
+	{Name: "Fluoride", Header: "Fluoride (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.FluorideUg }},
+	{Name: "Iodine", Header: "Iodine (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.IodineUg }},
+	{Name: "Iron", Header: "Iron (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.IronMg }},
+	{Name: "Magnesium", Header: "Magnesium (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.MagnesiumMg }},
+	{Name: "Manganese", Header: "Manganese (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.ManganeseMg }},
+	{Name: "Phosphorus", Header: "Phosphorus (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.PhosphorusMg }},
+	{Name: "Potassium", Header: "Potassium (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.PotassiumMg }},
+	{Name: "Selenium", Header: "Selenium (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.SeleniumUg }},
+	{Name: "Sodium", Header: "Sodium (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.SodiumMg }},
+	{Name: "Zinc", Header: "Zinc (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.ZincMg }},
+	{Name: "Carbs", Header: "Carbs (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.CarbsG }},
+	{Name: "Fiber", Header: "Fiber (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.FiberG }},
+	{Name: "Fructose", Header: "Fructose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.FructoseG }},
+	{Name: "Galactose", Header: "Galactose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.GalactoseG }},
+	{Name: "Glucose", Header: "Glucose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.GlucoseG }},
+	{Name: "Lactose", Header: "Lactose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.LactoseG }},
+	{Name: "Maltose", Header: "Maltose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.MaltoseG }},
+	{Name: "Starch", Header: "Starch (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.StarchG }},
+	{Name: "Sucrose", Header: "Sucrose (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.SucroseG }},
+	{Name: "Sugars", Header: "Sugars (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.SugarsG }},
+	{Name: "Net Carbs", Header: "Net Carbs (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.NetCarbsG }},
+	{Name: "Fat", Header: "Fat (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.FatG }},
+	{Name: "Cholesterol", Header: "Cholesterol (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.CholesterolMg }},
+	{Name: "Monounsaturated", Header: "Monounsaturated (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.MonounsaturatedG }},
+	{Name: "Polyunsaturated", Header: "Polyunsaturated (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.PolyunsaturatedG }},
+	{Name: "Saturated", Header: "Saturated (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.SaturatedG }},
+	{Name: "Trans-Fats", Header: "Trans-Fats (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.TransFatG }},
+	{Name: "Omega-3", Header: "Omega-3 (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.Omega3G }},
+	{Name: "Omega-6", Header: "Omega-6 (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.Omega6G }},
+	{Name: "Cystine", Header: "Cystine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.CystineG }},
+	{Name: "Histidine", Header: "Histidine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.HistidineG }},
+	{Name: "Isoleucine", Header: "Isoleucine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.IsoleucineG }},
+	{Name: "Leucine", Header: "Leucine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.LeucineG }},
+	{Name: "Lysine", Header: "Lysine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.LysineG }},
+	{Name: "Methionine", Header: "Methionine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.MethionineG }},
+	{Name: "Phenylalanine", Header: "Phenylalanine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.PhenylalanineG }},
+	{Name: "Protein", Header: "Protein (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.ProteinG }},
+	{Name: "Threonine", Header: "Threonine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.ThreonineG }},
+	{Name: "Tryptophan", Header: "Tryptophan (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.TryptophanG }},
+	{Name: "Tyrosine", Header: "Tyrosine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.TyrosineG }},
+	{Name: "Valine", Header: "Valine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.ValineG }},
+	{Name: "Alcohol", Header: "Alcohol (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.AlcoholG }},
+	{Name: "Added Sugars", Header: "Added Sugars (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.AddedSugarsG }},
+	{Name: "Sugar Alcohols", Header: "Sugar Alcohols (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.SugarAlcoholsG }},
+	{Name: "Oxalate", Header: "Oxalate (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.OxalateMg }},
+	{Name: "Phytate", Header: "Phytate (mg)", Unit: UnitMilligram, field: func(s *ServingRecord) *float64 { return &s.PhytateMg }},
+	{Name: "Molybdenum", Header: "Molybdenum (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.MolybdenumUg }},
+	{Name: "Retinol", Header: "Retinol (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.RetinolUg }},
+	{Name: "Alpha-carotene", Header: "Alpha-carotene (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.AlphaCaroteneUg }},
+	{Name: "Beta-carotene", Header: "Beta-carotene (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.BetaCaroteneUg }},
+	{Name: "Beta-cryptoxanthin", Header: "Beta-cryptoxanthin (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.BetaCryptoxanthinUg }},
+	{Name: "Lycopene", Header: "Lycopene (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.LycopeneUg }},
+	{Name: "Lutein+Zeaxanthin", Header: "Lutein+Zeaxanthin (µg)", Unit: UnitMicrogram, field: func(s *ServingRecord) *float64 { return &s.LuteinZeaxanthinUg }},
+	{Name: "Alanine", Header: "Alanine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.AlanineG }},
+	{Name: "Arginine", Header: "Arginine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.ArginineG }},
+	{Name: "Aspartic acid", Header: "Aspartic acid (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.AsparticAcidG }},
+	{Name: "Glutamic acid", Header: "Glutamic acid (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.GlutamicAcidG }},
+	{Name: "Glycine", Header: "Glycine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.GlycineG }},
+	{Name: "Proline", Header: "Proline (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.ProlineG }},
+	{Name: "Serine", Header: "Serine (g)", Unit: UnitGram, field: func(s *ServingRecord) *float64 { return &s.SerineG }},
+}
+
+// Nutrients returns the description of every nutrient parsed from the servings export.
+func Nutrients() []Nutrient {
+	return append([]Nutrient(nil), nutrients...)
+}
+
+// LookupNutrient finds the nutrient by its name or header. Names are matched without regard to case.
+func LookupNutrient(name string) (Nutrient, bool) {
+	for _, n := range nutrients {
+		if strings.EqualFold(n.Name, name) || n.Header == name {
+			return n, true
+		}
+	}
+
+	return Nutrient{}, false
+}
+
+// Quantity returns the amount of the named nutrient in the serving with its unit. False is returned if the nutrient
+// is not known.
+func (s ServingRecord) Quantity(name string) (Quantity, bool) {
+	n, ok := LookupNutrient(name)
+	if !ok {
+		return Quantity{}, false
+	}
+
+	return n.Quantity(s), true
+}
@@ -0,0 +1,77 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNutrients_Headers(t *testing.T) {
+	var headers []string
+	for _, n := range gocronometer.Nutrients() {
+		headers = append(headers, n.Header)
+	}
+
+	// Every nutrient must be understood by the parser.
+	report := gocronometer.ValidateServingsHeaders(headers)
+	if len(report.Unrecognized) != 0 {
+		t.Fatalf("nutrients not understood by the parser: %q", report.Unrecognized)
+	}
+}
+
+func TestServingRecord_Quantity(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Vitamin K (µg),B12 (Cobalamin) (µg),Sodium (mg)\n" +
+		"2021-06-01,12:00,Kale,1 cup,113,0,29\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := servings[0]
+	if s.VitaminKUg != 113 {
+		t.Fatalf("expected vitamin K of 113 µg but found %f", s.VitaminKUg)
+	}
+
+	q, ok := s.Quantity("vitamin k")
+	if !ok {
+		t.Fatalf("vitamin K was not found")
+	}
+
+	if q.Value != 113 || q.Unit != gocronometer.UnitMicrogram {
+		t.Fatalf("unexpected vitamin K quantity %s", q)
+	}
+
+	sodium, ok := s.Quantity("Sodium (mg)")
+	if !ok {
+		t.Fatalf("sodium was not found")
+	}
+
+	grams, err := sodium.In(gocronometer.UnitGram)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(grams.Value-0.029) > 1e-12 {
+		t.Fatalf("expected 0.029 g of sodium but found %s", grams)
+	}
+
+	if _, err := sodium.In(gocronometer.UnitIU); err == nil {
+		t.Fatalf("expected an error converting mg to IU")
+	}
+}
+
+func TestNutrient_Set(t *testing.T) {
+	n, ok := gocronometer.LookupNutrient("Vitamin K")
+	if !ok {
+		t.Fatal("expected vitamin k to be known")
+	}
+
+	var s gocronometer.ServingRecord
+	n.Set(&s, 12.5)
+
+	if s.VitaminKUg != 12.5 || s.VitaminKMg != 12.5 {
+		t.Fatalf("expected vitamin k and its deprecated field to be set but found %+v", s)
+	}
+}
@@ -0,0 +1,80 @@
+package gocronometer
+
+import (
+	"sort"
+	"time"
+)
+
+// OmegaRatio is the ratio of omega-6 to omega-3 fatty acids of a day.
+type OmegaRatio struct {
+	Day     Date
+	Omega6G float64
+	Omega3G float64
+
+	// Ratio is Omega6G divided by Omega3G. It is zero when Defined is false.
+	Ratio float64
+
+	// Defined is false when the day has no omega-3, as the ratio is undefined.
+	Defined bool
+}
+
+// newOmegaRatio calculates the ratio of the day, leaving it undefined without omega-3.
+func newOmegaRatio(day Date, omega6 float64, omega3 float64) OmegaRatio {
+	r := OmegaRatio{Day: day, Omega6G: omega6, Omega3G: omega3}
+	if omega3 > 0 {
+		r.Ratio = omega6 / omega3
+		r.Defined = true
+	}
+
+	return r
+}
+
+// OmegaRatio returns the ratio of omega-6 to omega-3 of all the servings combined. False is returned when the
+// servings have no omega-3.
+func (s ServingRecords) OmegaRatio() (float64, bool) {
+	total := s.Total()
+	r := newOmegaRatio(Date{}, total.Omega6G, total.Omega3G)
+	return r.Ratio, r.Defined
+}
+
+// DailyOmegaRatios returns the ratio of omega-6 to omega-3 of each day of the servings, sorted by day. Days without
+// omega-3 are included with an undefined ratio so gaps in the data remain visible.
+func (s ServingRecords) DailyOmegaRatios() []OmegaRatio {
+	ratios := make([]OmegaRatio, 0)
+	for day, servings := range GroupBy(s, func(s ServingRecord) Date { return s.Day }) {
+		total := servings.Total()
+		ratios = append(ratios, newOmegaRatio(day, total.Omega6G, total.Omega3G))
+	}
+
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].Day.Before(ratios[j].Day)
+	})
+
+	return ratios
+}
+
+// OmegaRatioTrend returns the change of the ratio per day over the range of the ratios, as the slope of the least
+// squares line through the defined ratios. A negative trend is an improving ratio. False is returned when fewer than
+// two days have a defined ratio.
+func OmegaRatioTrend(ratios []OmegaRatio) (float64, bool) {
+	var n, sumX, sumY, sumXX, sumXY float64
+	for _, r := range ratios {
+		if !r.Defined {
+			continue
+		}
+
+		x := float64(r.Day.Time(time.UTC).Unix()) / (24 * 60 * 60)
+		n++
+		sumX += x
+		sumY += r.Ratio
+		sumXX += x * x
+		sumXY += x * r.Ratio
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if n < 2 || denominator == 0 {
+		return 0, false
+	}
+
+	return (n*sumXY - sumX*sumY) / denominator, true
+}
@@ -0,0 +1,50 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestServingRecords_DailyOmegaRatios(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: day.AddDays(2), Omega6G: 4, Omega3G: 2},
+		{Day: day, Omega6G: 10, Omega3G: 0.5},
+		{Day: day, Omega6G: 2, Omega3G: 0.5},
+		{Day: day.AddDays(1), Omega6G: 5},
+	}
+
+	ratios := servings.DailyOmegaRatios()
+	if len(ratios) != 3 {
+		t.Fatalf("expected 3 days but found %+v", ratios)
+	}
+	if ratios[0].Day != day || ratios[0].Ratio != 12 || !ratios[0].Defined {
+		t.Fatalf("unexpected ratio of the first day: %+v", ratios[0])
+	}
+	if ratios[1].Defined || ratios[1].Ratio != 0 || ratios[1].Omega6G != 5 {
+		t.Fatalf("expected an undefined ratio for the day without omega-3 but found %+v", ratios[1])
+	}
+
+	trend, ok := gocronometer.OmegaRatioTrend(ratios)
+	if !ok || math.Abs(trend-(-5)) > 1e-9 {
+		t.Fatalf("expected a trend of -5 per day but found %f, %t", trend, ok)
+	}
+
+	ratio, ok := servings.OmegaRatio()
+	if !ok || ratio != 21.0/3 {
+		t.Fatalf("expected a ratio of 7 but found %f, %t", ratio, ok)
+	}
+}
+
+func TestOmegaRatioTrend(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	if _, ok := gocronometer.OmegaRatioTrend([]gocronometer.OmegaRatio{{Day: day, Ratio: 4, Defined: true}, {Day: day.AddDays(1)}}); ok {
+		t.Fatal("expected no trend with a single defined ratio")
+	}
+
+	if _, ok := (gocronometer.ServingRecords{{Omega6G: 3}}).OmegaRatio(); ok {
+		t.Fatal("expected no ratio without omega-3")
+	}
+}
@@ -0,0 +1,166 @@
+package gocronometer
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// OutlierMethod is the statistic used to detect outliers among the amounts of a metric.
+type OutlierMethod int
+
+// The following are the methods of detecting outliers.
+const (
+	// OutlierZScore flags the amounts more than the threshold standard deviations from the mean.
+	OutlierZScore OutlierMethod = iota
+
+	// OutlierIQR flags the amounts more than the threshold interquartile ranges below the first quartile or above the
+	// third quartile. It is less affected by the outliers themselves than OutlierZScore.
+	OutlierIQR
+)
+
+// The following are the default thresholds of the methods.
+const (
+	DefaultOutlierZScore = 3
+	DefaultOutlierIQR    = 1.5
+)
+
+// OutlierOptions configures Outliers. Zero values revert to the library defaults.
+type OutlierOptions struct {
+	// Method is the statistic used to detect outliers. Defaults to OutlierZScore.
+	Method OutlierMethod
+
+	// Threshold is the threshold of the method. Defaults to DefaultOutlierZScore or DefaultOutlierIQR.
+	Threshold float64
+
+	// MaxChangePerDay additionally flags the amounts that change by more than it per day from the previous amount
+	// that is not an outlier, such as a weight logged in pounds instead of kilograms. Disabled when zero.
+	MaxChangePerDay float64
+}
+
+// BiometricOutlier is a biometric that is implausible compared to the other recordings of its metric.
+type BiometricOutlier struct {
+	// Index is the index of the biometric in the biometrics checked.
+	Index int
+
+	Biometric BiometricRecord
+
+	// Change is true when the biometric was flagged by MaxChangePerDay rather than the method of the options.
+	Change bool
+}
+
+// Outliers returns the recordings of the metric that are outliers, in the order of the biometrics. Metrics are matched
+// without regard to case and only recordings in the unit of the first recording are checked, as in Trend. The method
+// needs at least three recordings to flag any. A nil opts uses the defaults.
+func (b BiometricRecords) Outliers(metric string, opts *OutlierOptions) []BiometricOutlier {
+	if opts == nil {
+		opts = &OutlierOptions{}
+	}
+
+	indexes := b.metricIndexes(metric)
+	flagged := make(map[int]bool)
+
+	amounts := make([]float64, len(indexes))
+	for i, index := range indexes {
+		amounts[i] = b[index].Amount
+	}
+	low, high := outlierBounds(amounts, opts)
+	for _, index := range indexes {
+		if b[index].Amount < low || b[index].Amount > high {
+			flagged[index] = false
+		}
+	}
+
+	if opts.MaxChangePerDay > 0 {
+		ordered := append([]int(nil), indexes...)
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return b[ordered[i]].RecordedTime.Before(b[ordered[j]].RecordedTime)
+		})
+
+		previous := -1
+		for _, index := range ordered {
+			if _, ok := flagged[index]; ok {
+				continue
+			}
+			if previous >= 0 {
+				days := math.Max(1, float64(daysBetween(b[previous].Day, b[index].Day)))
+				if math.Abs(b[index].Amount-b[previous].Amount)/days > opts.MaxChangePerDay {
+					flagged[index] = true
+					continue
+				}
+			}
+			previous = index
+		}
+	}
+
+	outliers := make([]BiometricOutlier, 0, len(flagged))
+	for _, index := range indexes {
+		if change, ok := flagged[index]; ok {
+			outliers = append(outliers, BiometricOutlier{Index: index, Biometric: b[index], Change: change})
+		}
+	}
+
+	return outliers
+}
+
+// metricIndexes returns the indexes of the recordings of the metric in the unit of its first recording.
+func (b BiometricRecords) metricIndexes(metric string) []int {
+	var unit string
+	indexes := make([]int, 0)
+	for i, r := range b {
+		if !strings.EqualFold(r.Metric, metric) {
+			continue
+		}
+		if len(indexes) == 0 {
+			unit = r.Unit
+		}
+		if r.Unit == unit {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// outlierBounds returns the lowest and highest amounts that are not outliers by the method of the options.
+func outlierBounds(amounts []float64, opts *OutlierOptions) (float64, float64) {
+	if len(amounts) < 3 {
+		return math.Inf(-1), math.Inf(1)
+	}
+
+	threshold := opts.Threshold
+	if opts.Method == OutlierIQR {
+		if threshold == 0 {
+			threshold = DefaultOutlierIQR
+		}
+		sorted := append([]float64(nil), amounts...)
+		sort.Float64s(sorted)
+		q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+		return q1 - threshold*(q3-q1), q3 + threshold*(q3-q1)
+	}
+
+	if threshold == 0 {
+		threshold = DefaultOutlierZScore
+	}
+	var sum, squares float64
+	for _, a := range amounts {
+		sum += a
+	}
+	mean := sum / float64(len(amounts))
+	for _, a := range amounts {
+		squares += (a - mean) * (a - mean)
+	}
+	deviation := math.Sqrt(squares / float64(len(amounts)))
+	return mean - threshold*deviation, mean + threshold*deviation
+}
+
+// quantile returns the quantile q of the sorted amounts, interpolating linearly between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	if lower+1 >= len(sorted) {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*(position-float64(lower))
+}
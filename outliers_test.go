@@ -0,0 +1,49 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func weights(amounts ...float64) gocronometer.BiometricRecords {
+	records := make(gocronometer.BiometricRecords, len(amounts))
+	for i, amount := range amounts {
+		day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}.AddDays(i)
+		records[i] = gocronometer.BiometricRecord{Day: day, RecordedTime: day.Time(time.UTC), Metric: "Weight", Unit: "kg", Amount: amount}
+	}
+	return records
+}
+
+func TestOutliers(t *testing.T) {
+	records := weights(80, 80.2, 79.9, 80.1, 79.8, 80, 80.3, 79.7, 80.1, 80, 176.4, 79.9)
+
+	outliers := records.Outliers("weight", nil)
+	if len(outliers) != 1 || outliers[0].Index != 10 || outliers[0].Change {
+		t.Fatalf("expected the pound recording to be flagged but found %+v", outliers)
+	}
+
+	outliers = records.Outliers("Weight", &gocronometer.OutlierOptions{Method: gocronometer.OutlierIQR})
+	if len(outliers) == 0 || outliers[len(outliers)-1].Index != 10 {
+		t.Fatalf("expected the pound recording to be flagged by IQR but found %+v", outliers)
+	}
+
+	records = weights(80, 80.2, 90, 80.1)
+	outliers = records.Outliers("Weight", &gocronometer.OutlierOptions{Threshold: 100, MaxChangePerDay: 2})
+	if len(outliers) != 1 || outliers[0].Index != 2 || !outliers[0].Change {
+		t.Fatalf("expected the overnight change to be flagged but found %+v", outliers)
+	}
+
+	if outliers := weights(80, 90).Outliers("Weight", nil); len(outliers) != 0 {
+		t.Fatalf("expected no outliers of 2 recordings but found %+v", outliers)
+	}
+}
+
+func TestTrendExcludeOutliers(t *testing.T) {
+	records := weights(80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 176.4, 80)
+
+	points := records.WeightTrend(&gocronometer.TrendOptions{ExcludeOutliers: &gocronometer.OutlierOptions{}})
+	if len(points) != 12 || !points[10].Interpolated || points[11].Trend != 80 {
+		t.Fatalf("expected the outlier to be interpolated over but found %+v", points)
+	}
+}
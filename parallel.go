@@ -0,0 +1,109 @@
+package gocronometer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExportServingsParallel exports the servings within the date range by splitting it into chunks of chunkDays days and
+// downloading up to concurrency chunks at once. The chunks are merged with MergeServings so the result is sorted by
+// time. It is intended for backfilling ranges of several years, which are slow to export in a single request. Only the
+// YYYY-mm-dd is utilized of startDate and endDate, and the recorded times are set to the location of startDate. The
+// first chunk to fail cancels the others and its error is returned.
+func (c *Client) ExportServingsParallel(ctx context.Context, startDate time.Time, endDate time.Time, chunkDays int, concurrency int) (ServingRecords, error) {
+	location := startDate.Location()
+
+	chunks, err := exportChunks(ctx, startDate, endDate, chunkDays, concurrency, c.Progress, func(ctx context.Context, start time.Time, end time.Time) (ServingRecords, error) {
+		raw, err := c.ExportServings(ctx, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("retreiving raw data: %w", err)
+		}
+
+		servings, err := ParseServingsExport(strings.NewReader(raw), location)
+		if err != nil {
+			return nil, fmt.Errorf("parsing raw data: %w", err)
+		}
+		return servings, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return MergeServings(chunks...), nil
+}
+
+// exportChunks splits the date range into chunks of chunkDays days and exports them with up to concurrency exports
+// at once, reporting each completed chunk to progress if it is not nil. The exports are returned in the order of the
+// chunks. Chunk sizes and concurrency below one are treated as one.
+func exportChunks[S any](ctx context.Context, startDate time.Time, endDate time.Time, chunkDays int, concurrency int, progress Progress, export func(ctx context.Context, start time.Time, end time.Time) (S, error)) ([]S, error) {
+	if chunkDays < 1 {
+		chunkDays = 1
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	type chunk struct {
+		start, end time.Time
+	}
+
+	location := startDate.Location()
+	last := DateOf(endDate)
+	var chunks []chunk
+	for d := DateOf(startDate); !d.After(last); d = d.AddDays(chunkDays) {
+		end := d.AddDays(chunkDays - 1)
+		if end.After(last) {
+			end = last
+		}
+		chunks = append(chunks, chunk{start: d.Time(location), end: end.Time(location)})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	counter := &chunkCounter{progress: progress, total: len(chunks)}
+
+	var (
+		results  = make([]S, len(chunks))
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		slots    = make(chan struct{}, concurrency)
+	)
+	for i, ch := range chunks {
+		wg.Add(1)
+		go func(i int, ch chunk) {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				return
+			}
+
+			result, err := export(ctx, ch.start, ch.end)
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("exporting %s to %s: %w", DateOf(ch.start), DateOf(ch.end), err)
+					cancel()
+				})
+				return
+			}
+			results[i] = result
+			counter.done()
+		}(i, ch)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
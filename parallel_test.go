@@ -0,0 +1,74 @@
+package gocronometer_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_ExportServingsParallel(t *testing.T) {
+	var exports, chunks, downloaded int32
+	client := gocronometer.NewClient(&gocronometer.ClientOptions{Progress: gocronometer.ProgressFuncs{
+		DownloadedFunc: func(export string, bytes int64) {
+			if export == "servings" {
+				atomic.AddInt32(&downloaded, 1)
+			}
+		},
+		ChunkCompletedFunc: func(completed int, total int) {
+			if total != 3 {
+				t.Errorf("expected 3 chunks but found %d", total)
+			}
+			atomic.AddInt32(&chunks, 1)
+		},
+	}})
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := `//OK["token"]`
+		if req.URL.Query().Get("generate") == "servings" {
+			atomic.AddInt32(&exports, 1)
+			// Every chunk includes a serving on the first day of the backfill to check that duplicates are merged.
+			body = fmt.Sprintf("Day,Time,Food Name,Energy (kcal)\n2021-01-01,08:00,Eggs,140\n%s,12:00,Soup,200\n",
+				req.URL.Query().Get("start"))
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{},
+			Request:    req,
+		}, nil
+	})
+
+	servings, err := client.ExportServingsParallel(context.Background(),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 25, 0, 0, 0, 0, time.UTC), 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if exports != 3 || chunks != 3 || downloaded < 3 {
+		t.Fatalf("expected 3 chunks to be exported and reported but found %d exported, %d reported and %d downloads",
+			exports, chunks, downloaded)
+	}
+
+	// Eggs once and soup at the start of each chunk.
+	if len(servings) != 4 || servings[0].FoodName != "Eggs" || servings[3].Day.String() != "2021-01-21" {
+		t.Fatalf("unexpected servings: %+v", servings)
+	}
+}
+
+func TestClient_ExportServingsParallel_Error(t *testing.T) {
+	client := gocronometer.NewClient(nil)
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("offline")
+	})
+
+	_, err := client.ExportServingsParallel(context.Background(),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), 7, 3)
+	if err == nil || !strings.Contains(err.Error(), "offline") {
+		t.Fatalf("expected the chunk error but found %v", err)
+	}
+}
@@ -1,19 +1,26 @@
 package gocronometer
 
 import (
-	"encoding/csv"
+	"errors"
 	"fmt"
+	"github.com/burke/gocronometer/internal/decimal"
 	"io"
 	"os"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 type ServingRecord struct {
 	RecordedTime     time.Time
+	Day              Date    // Day as shown in Cronometer, unaffected by the location of RecordedTime.
+	HasTime          bool    // HasTime is false when the export had no time and RecordedTime uses the default time.
+	Raw              *RawRow // Raw is the row the serving was parsed from when ParseOptions.KeepRaw is set.
 	Group            string
 	FoodName         string
+	Source           string // Source is the database the food came from, such as NCCDB or USDA, when exported.
+	FoodID           string // FoodID is the identifier of the food within Source, when exported.
 	QuantityValue    float64
 	QuantityUnits    string
 	EnergyKcal       float64
@@ -24,7 +31,7 @@ type ServingRecord struct {
 	B3Mg             float64
 	B5Mg             float64
 	B6Mg             float64
-	B12Mg            float64
+	B12Ug            float64
 	BiotinUg         float64
 	CholineMg        float64
 	FolateUg         float64
@@ -32,7 +39,7 @@ type ServingRecord struct {
 	VitaminCMg       float64
 	VitaminDUI       float64
 	VitaminEMg       float64
-	VitaminKMg       float64
+	VitaminKUg       float64
 	CalciumMg        float64
 	ChromiumUg       float64
 	CopperMg         float64
@@ -79,6 +86,36 @@ type ServingRecord struct {
 	IronMg           float64
 	AlcoholG         float64
 	Category         string
+
+	// The following nutrients are only found in newer exports.
+	OxalateMg           float64
+	PhytateMg           float64
+	MolybdenumUg        float64
+	RetinolUg           float64
+	AlphaCaroteneUg     float64
+	BetaCaroteneUg      float64
+	BetaCryptoxanthinUg float64
+	LycopeneUg          float64
+	LuteinZeaxanthinUg  float64
+	AlanineG            float64
+	ArginineG           float64
+	AsparticAcidG       float64
+	GlutamicAcidG       float64
+	GlycineG            float64
+	ProlineG            float64
+	SerineG             float64
+	AddedSugarsG        float64
+	SugarAlcoholsG      float64
+
+	// B12Mg is the vitamin B12 of the serving in µg.
+	//
+	// Deprecated: Despite the name the value is in µg as exported by Cronometer. Use B12Ug.
+	B12Mg float64
+
+	// VitaminKMg is the vitamin K of the serving in µg.
+	//
+	// Deprecated: Despite the name the value is in µg as exported by Cronometer. Use VitaminKUg.
+	VitaminKMg float64
 }
 
 type ServingRecords []ServingRecord
@@ -91,482 +128,517 @@ const (
 	DateTimeFormat = "2006-01-02 15:04"
 )
 
-// parseDateTime handles parsing of Cronometer date+time strings
-func parseDateTime(date, timeStr string, location *time.Location) (time.Time, error) {
+// separators returns the decimal and grouping separators of the options. A nil receiver uses the defaults.
+func (o *ParseOptions) separators() (decimal rune, grouping rune) {
+	decimal, grouping = '.', ','
+	if o == nil {
+		return decimal, grouping
+	}
+
+	if o.DecimalSeparator != 0 {
+		decimal = o.DecimalSeparator
+		if decimal == ',' {
+			grouping = '.'
+		}
+	}
+	if o.GroupingSeparator != 0 {
+		grouping = o.GroupingSeparator
+	}
+
+	return decimal, grouping
+}
+
+// parseFloat parses s as a float using the number format of the options. A nil receiver uses the defaults.
+func (o *ParseOptions) parseFloat(s string) (float64, error) {
+	separator, grouping := o.separators()
+
+	// Most values are plain decimals converted without normalizing them first.
+	if separator < utf8.RuneSelf {
+		if f, ok := decimal.Parse(s, byte(separator)); ok {
+			return f, nil
+		}
+	}
+
+	s, err := normalizeNumber(s, separator, grouping)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseFloat(s, 64)
+}
+
+// normalizeNumber converts s from the number format described by the separators into the format expected by
+// strconv.ParseFloat.
+func normalizeNumber(s string, decimal rune, grouping rune) (string, error) {
+	s = strings.TrimSpace(s)
+	if decimal == '.' && !strings.ContainsRune(s, grouping) {
+		return s, nil
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexRune(s, decimal); i >= 0 {
+		intPart, fracPart = s[:i], s[i+len(string(decimal)):]
+	}
+
+	if strings.ContainsRune(intPart, grouping) {
+		groups := strings.Split(intPart, string(grouping))
+		for i, g := range groups {
+			digits := strings.TrimLeft(g, "+-")
+			if (i == 0 && (len(digits) == 0 || len(digits) > 3)) || (i > 0 && len(g) != 3) {
+				return "", fmt.Errorf("invalid digit grouping in %q", s)
+			}
+		}
+		intPart = strings.Join(groups, "")
+	}
+
+	if fracPart == "" && !strings.ContainsRune(s, decimal) {
+		return intPart, nil
+	}
+
+	return intPart + "." + fracPart, nil
+}
+
+// timeLayouts are the time of day layouts accepted in the Time column of exports. The first layout matches the
+// 24-hour format used by DateTimeFormat.
+var timeLayouts = []string{
+	"15:04",
+	"15:04:05",
+	"3:04 PM",
+	"3:04:05 PM",
+	"3:04PM",
+	"3:04:05PM",
+}
+
+// dayTime returns the time of the day at the time of day timeStr, or at the default time when timeStr is empty. Times
+// around a daylight saving transition are resolved by the DSTPolicy. A nil receiver uses the defaults.
+func (o *ParseOptions) dayTime(day Date, timeStr string, location *time.Location) (time.Time, error) {
 	if location == nil {
 		location = time.UTC
 	}
 
-	date = strings.TrimSpace(date)
-	timeStr = strings.TrimSpace(timeStr)
+	timeStr = strings.ToUpper(strings.TrimSpace(timeStr))
 
-	// Default to midnight if no time provided
+	// Use the default time of day if no time provided.
 	if timeStr == "" {
-		timeStr = "00:00"
+		var defaultTime time.Duration
+		if o != nil {
+			defaultTime = o.DefaultTime
+		}
+
+		// Building from the clock values rather than adding the duration keeps the time of day correct on days
+		// with a daylight saving transition.
+		return o.localTime(day, int(defaultTime/time.Hour), int(defaultTime%time.Hour/time.Minute),
+			int(defaultTime%time.Minute/time.Second), location)
+	}
+
+	// Try each of the supported time layouts, setting the clock of the time found on the day.
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, timeStr)
+		if err == nil {
+			return o.localTime(day, t.Hour(), t.Minute(), t.Second(), location)
+		}
+	}
+
+	// Reporting the error against the default format.
+	dateTimeStr := day.String() + " " + timeStr
+	_, err := time.ParseInLocation(DateTimeFormat, dateTimeStr, location)
+
+	return time.Time{}, fmt.Errorf("invalid date/time format %q: %w", dateTimeStr, err)
+}
+
+// RowError describes a row of an export that failed to parse.
+type RowError struct {
+	// Row is the line number of the row within the CSV, with the header being row 1.
+	Row int
+
+	// Column is the header of the column that failed to parse. It is empty if the row itself could not be read.
+	Column string
+
+	// Value is the raw value of the column that failed to parse.
+	Value string
+
+	Err error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+	}
+	return fmt.Sprintf("row %d column %q value %q: %s", e.Row, e.Column, e.Value, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// RowErrors is returned by the parsers in lenient mode when one or more rows failed to parse.
+type RowErrors []*RowError
+
+func (e RowErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
 	}
+	return fmt.Sprintf("%d rows failed to parse, first error: %s", len(e), e[0])
+}
+
+// RawRow is the raw CSV row a record was parsed from. It is only kept when ParseOptions.KeepRaw is set.
+type RawRow struct {
+	// Headers are the headers of the export as found in the CSV, before any HeaderMapping is applied. The slice is
+	// shared by every row of an export and must not be modified.
+	Headers []string
 
-	// Combine date and time
-	dateTimeStr := date + " " + timeStr
+	// Fields are the raw values of the row in the order of Headers.
+	Fields []string
 
-	// Parse with location
-	t, err := time.ParseInLocation(DateTimeFormat, dateTimeStr, location)
+	index map[string]int
+}
+
+// Get returns the raw value of the column with the header provided. False is returned if the export has no such
+// column.
+func (r *RawRow) Get(header string) (string, bool) {
+	i, ok := r.index[header]
+	if !ok || i >= len(r.Fields) {
+		return "", false
+	}
+
+	return r.Fields[i], true
+}
+
+// rowInfo contains the values common to every record type that are parsed from a row.
+type rowInfo struct {
+	row          int
+	day          Date
+	hasTime      bool
+	recordedTime time.Time
+	raw          *RawRow
+
+	// label is the value of the Day column of rows labeled rather than dated, such as the target rows of the daily
+	// nutrition export. The other fields are unset for those rows.
+	label string
+}
+
+// columnParser sets the field of a record for a column of the export to the value v.
+type columnParser[T any] func(record *T, v string) error
+
+// parseExport reads every row of the CSV export, which may be gzip compressed, into a record. The Day and Time columns
+// are handled here while the parser of every other column is resolved once from its header by column, which returns
+// nil for columns that are ignored. Columns excluded by ParseOptions.Columns are ignored without being resolved. Once
+// the row is parsed the values common to every record type are passed to finish. In lenient mode rows that fail are
+// collected into RowErrors, otherwise the first failure is returned as a *RowError.
+func parseExport[T any](rawCSVReader io.Reader, opts *ParseOptions,
+	column func(header string) columnParser[T],
+	finish func(record *T, info rowInfo)) ([]T, error) {
+	return parseLabeledExport(rawCSVReader, opts, nil, column, finish)
+}
+
+// parseLabeledExport is parseExport for exports mixing rows of days with rows labeled otherwise in the Day column. The
+// rows for which labeled returns true are given to finish with only their label and raw row, rather than failing to
+// parse as a day.
+func parseLabeledExport[T any](rawCSVReader io.Reader, opts *ParseOptions, labeled func(day string) bool,
+	column func(header string) columnParser[T],
+	finish func(record *T, info rowInfo)) ([]T, error) {
+
+	// Exports stored compressed are decompressed transparently.
+	rawCSVReader, err := decompress(rawCSVReader)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid date/time format %q: %w", dateTimeStr, err)
+		return nil, err
+	}
+
+	lenient := opts != nil && opts.Lenient
+	r := opts.newRowReader(opts.limitReader(rawCSVReader), lenient)
+
+	location := opts.Location
+	if location == nil {
+		location = time.UTC
 	}
 
-	return t, nil
+	p := &exportParser[T]{opts: opts, lenient: lenient, location: location, dayIndex: -1, timeIndex: -1, labeled: labeled, finish: finish}
+
+	// Resolving the parser of every column from the headers.
+	headers, err := p.next(r, 1)
+	if err != nil {
+		return nil, err
+	}
+	if headers == nil {
+		return make([]T, 0), nil
+	}
+	p.resolve(headers, column)
+
+	if opts.Workers > 1 {
+		return p.parseParallel(r)
+	}
+	return p.parseSequential(r)
 }
 
-func ParseServingsExport(rawCSVReader io.Reader, location *time.Location) (ServingRecords, error) {
+// exportParser converts the rows of an export into records of type T once the parsers of its columns are resolved.
+type exportParser[T any] struct {
+	opts     *ParseOptions
+	lenient  bool
+	location *time.Location
+	labeled  func(day string) bool
+	finish   func(record *T, info rowInfo)
+
+	headers    []string
+	parsers    []columnParser[T]
+	dayIndex   int
+	timeIndex  int
+	rawHeaders []string
+	rawIndex   map[string]int
+}
+
+// resolve resolves the parser of every column from the headers of the export.
+func (p *exportParser[T]) resolve(headers []string, column func(header string) columnParser[T]) {
+	projection := p.opts.projection()
+
+	p.rawHeaders = append([]string(nil), headers...)
+	p.rawIndex = make(map[string]int, len(headers))
+	p.headers = make([]string, len(headers))
+	p.parsers = make([]columnParser[T], len(headers))
+	for i, v := range p.rawHeaders {
+		p.rawIndex[v] = i
+		if mapped, ok := p.opts.HeaderMapping[v]; ok {
+			v = mapped
+		}
+		p.headers[i] = v
+
+		switch v {
+		case "Day":
+			p.dayIndex = i
+		case "Time":
+			p.timeIndex = i
+		default:
+			if projection == nil || projection[v] {
+				p.parsers[i] = column(v)
+			}
+		}
+	}
+}
+
+// next reads the row on line lineNum, returning a nil row at the end of the export. A row that cannot be read is
+// returned as a *RowError, which lenient mode skips, while exceeding a limit is returned as it is.
+func (p *exportParser[T]) next(r rowReader, lineNum int) ([]string, error) {
+	row, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if p.opts.Progress != nil && lineNum > 1 {
+		p.opts.Progress.Parsed(lineNum - 1)
+	}
+
+	if errors.Is(err, ErrLimitExceeded) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, &RowError{Row: lineNum, Err: err}
+	}
+	if err := p.opts.checkRow(row, lineNum); err != nil {
+		return nil, err
+	}
 
-	r := csv.NewReader(rawCSVReader)
+	return row, nil
+}
 
-	lineNum := 0
-	headers := make(map[int]string)
-	servings := make(ServingRecords, 0, 0)
+// parseRow converts the row on line lineNum into a record.
+func (p *exportParser[T]) parseRow(row []string, lineNum int, days *dayCache) (T, *RowError) {
+	var date string
+	var timeStr string
+	var record T
+	for i, v := range row {
+		switch {
+		case i >= len(p.parsers):
+		case i == p.dayIndex:
+			date = v
+		case i == p.timeIndex:
+			timeStr = v
+		case p.parsers[i] != nil:
+			if err := p.parsers[i](&record, v); err != nil {
+				return record, &RowError{Row: lineNum, Column: p.headers[i], Value: v, Err: err}
+			}
+		}
+	}
 
-	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
+	var info rowInfo
+	if p.labeled != nil && p.labeled(date) {
+		info = rowInfo{row: lineNum, label: date}
+	} else {
+		var rowErr *RowError
+		if info, rowErr = p.opts.parseRowInfo(days, date, timeStr, p.location, lineNum); rowErr != nil {
+			return record, rowErr
+		}
+	}
+
+	if p.opts.KeepRaw {
+		info.raw = &RawRow{Headers: p.rawHeaders, Fields: append([]string(nil), row...), index: p.rawIndex}
+	}
+
+	p.finish(&record, info)
+	return record, nil
+}
+
+// parseSequential reads and converts the rows after the headers on the calling goroutine.
+func (p *exportParser[T]) parseSequential(r rowReader) ([]T, error) {
+	records := make([]T, 0)
+	var rowErrs RowErrors
+	var days dayCache
+
+	for lineNum := 2; ; lineNum++ {
+		row, err := p.next(r, lineNum)
+		if rowErr, ok := err.(*RowError); ok && p.lenient {
+			rowErrs = append(rowErrs, rowErr)
+			continue
 		}
 		if err != nil {
 			return nil, err
 		}
+		if row == nil {
+			break
+		}
 
-		// Index all the headers.
-		if lineNum == 0 {
-
-			for i, v := range record {
-				headers[i] = v
+		record, rowErr := p.parseRow(row, lineNum, &days)
+		if rowErr != nil {
+			if !p.lenient {
+				return nil, rowErr
 			}
-			lineNum++
+			rowErrs = append(rowErrs, rowErr)
 			continue
 		}
-		lineNum++
-
-		var date string
-		var timeStr string
-		serving := ServingRecord{}
-		for i, v := range record {
-			columnName := headers[i]
-
-			switch columnName {
-			case "Day":
-				date = v
-			case "Time":
-				timeStr = v
-			case "Group":
-				serving.Group = v
-			case "Food Name":
-				serving.FoodName = v
-			case "Amount":
-				parts := strings.SplitN(v, " ", 2)
-				if len(parts) < 2 {
-					return nil, fmt.Errorf("invalid amount format %q, expected 'value unit'", v)
-				}
-				f, err := parseFloat(parts[0], 64)
-				if err != nil {
-					return nil, fmt.Errorf("parsing quantity value %q: %w", parts[0], err)
-				}
-				serving.QuantityValue = f
-				serving.QuantityUnits = parts[1]
-			case "Energy (kcal)":
-				f, err := parseNutrientFloat(v, "energy")
-				if err != nil {
-					return nil, err
-				}
-				serving.EnergyKcal = f
-			case "Caffeine (mg)":
-				f, err := parseNutrientFloat(v, "caffeine")
-				if err != nil {
-					return nil, err
-				}
-				serving.CaffeineMg = f
-			case "Water (g)":
-				f, err := parseNutrientFloat(v, "water")
-				if err != nil {
-					return nil, err
-				}
-				serving.WaterG = f
-			case "B1 (Thiamine) (mg)":
-				f, err := parseNutrientFloat(v, "vitamin B1")
-				if err != nil {
-					return nil, err
-				}
-				serving.B1Mg = f
-			case "B2 (Riboflavin) (mg)":
-				f, err := parseNutrientFloat(v, "vitamin B2")
-				if err != nil {
-					return nil, err
-				}
-				serving.B2Mg = f
-			case "B3 (Niacin) (mg)":
-				f, err := parseNutrientFloat(v, "vitamin B3")
-				if err != nil {
-					return nil, err
-				}
-				serving.B3Mg = f
-			case "B5 (Pantothenic Acid) (mg)":
-				f, err := parseNutrientFloat(v, "vitamin B5")
-				if err != nil {
-					return nil, err
-				}
-				serving.B5Mg = f
-			case "B6 (Pyridoxine) (mg)":
-				f, err := parseNutrientFloat(v, "vitamin B6")
-				if err != nil {
-					return nil, err
-				}
-				serving.B6Mg = f
-			case "B12 (Cobalamin) (µg)":
-				f, err := parseNutrientFloat(v, "vitamin B12")
-				if err != nil {
-					return nil, err
-				}
-				serving.B12Mg = f
-			case "Biotin (µg)":
-				f, err := parseNutrientFloat(v, "biotin")
-				if err != nil {
-					return nil, err
-				}
-				serving.BiotinUg = f
-			case "Choline (mg)":
-				f, err := parseNutrientFloat(v, "choline")
-				if err != nil {
-					return nil, err
-				}
-				serving.CholineMg = f
-			case "Folate (µg)":
-				f, err := parseNutrientFloat(v, "folate")
-				if err != nil {
-					return nil, err
-				}
-				serving.FolateUg = f
-			case "Vitamin A (µg)":
-				f, err := parseNutrientFloat(v, "vitamin A")
-				if err != nil {
-					return nil, err
-				}
-				serving.VitaminAUg = f
-			case "Vitamin C (mg)":
-				f, err := parseNutrientFloat(v, "vitamin C")
-				if err != nil {
-					return nil, err
-				}
-				serving.VitaminCMg = f
-			case "Vitamin D (IU)":
-				f, err := parseNutrientFloat(v, "vitamin D")
-				if err != nil {
-					return nil, err
-				}
-				serving.VitaminDUI = f
-			case "Vitamin E (mg)":
-				f, err := parseNutrientFloat(v, "vitamin E")
-				if err != nil {
-					return nil, err
-				}
-				serving.VitaminEMg = f
-			case "Vitamin K (µg)":
-				f, err := parseNutrientFloat(v, "vitamin K")
-				if err != nil {
-					return nil, err
-				}
-				serving.VitaminKMg = f
-			case "Calcium (mg)":
-				f, err := parseNutrientFloat(v, "calcium")
-				if err != nil {
-					return nil, err
-				}
-				serving.CalciumMg = f
-			case "Chromium (µg)":
-				f, err := parseNutrientFloat(v, "chromium")
-				if err != nil {
-					return nil, err
-				}
-				serving.ChromiumUg = f
-			case "Copper (mg)":
-				f, err := parseNutrientFloat(v, "copper")
-				if err != nil {
-					return nil, err
-				}
-				serving.CopperMg = f
-			case "Fluoride (µg)":
-				f, err := parseNutrientFloat(v, "fluoride")
-				if err != nil {
-					return nil, err
-				}
-				serving.FluorideUg = f
-			case "Iodine (µg)":
-				f, err := parseNutrientFloat(v, "iodine")
-				if err != nil {
-					return nil, err
-				}
-				serving.IodineUg = f
-			case "Iron (mg)":
-				f, err := parseNutrientFloat(v, "iron")
-				if err != nil {
-					return nil, err
-				}
-				serving.IronMg = f
-			case "Magnesium (mg)":
-				f, err := parseNutrientFloat(v, "magnesium")
-				if err != nil {
-					return nil, err
-				}
-				serving.MagnesiumMg = f
-			case "Manganese (mg)":
-				f, err := parseNutrientFloat(v, "manganese")
-				if err != nil {
-					return nil, err
-				}
-				serving.ManganeseMg = f
-			case "Phosphorus (mg)":
-				f, err := parseNutrientFloat(v, "phosphorus")
-				if err != nil {
-					return nil, err
-				}
-				serving.PhosphorusMg = f
-			case "Potassium (mg)":
-				f, err := parseNutrientFloat(v, "potassium")
-				if err != nil {
-					return nil, err
-				}
-				serving.PotassiumMg = f
-			case "Selenium (µg)":
-				f, err := parseNutrientFloat(v, "selenium")
-				if err != nil {
-					return nil, err
-				}
-				serving.SeleniumUg = f
-			case "Sodium (mg)":
-				f, err := parseNutrientFloat(v, "sodium")
-				if err != nil {
-					return nil, err
-				}
-				serving.SodiumMg = f
-			case "Zinc (mg)":
-				f, err := parseNutrientFloat(v, "zinc")
-				if err != nil {
-					return nil, err
-				}
-				serving.ZincMg = f
-			case "Carbs (g)":
-				f, err := parseNutrientFloat(v, "carbohydrates")
-				if err != nil {
-					return nil, err
-				}
-				serving.CarbsG = f
-			case "Fiber (g)":
-				f, err := parseNutrientFloat(v, "fiber")
-				if err != nil {
-					return nil, err
-				}
-				serving.FiberG = f
-			case "Fructose (g)":
-				f, err := parseNutrientFloat(v, "fructose")
-				if err != nil {
-					return nil, err
-				}
-				serving.FructoseG = f
-			case "Galactose (g)":
-				f, err := parseNutrientFloat(v, "galactose")
-				if err != nil {
-					return nil, err
-				}
-				serving.GalactoseG = f
-			case "Glucose (g)":
-				f, err := parseNutrientFloat(v, "glucose")
-				if err != nil {
-					return nil, err
-				}
-				serving.GlucoseG = f
-			case "Lactose (g)":
-				f, err := parseNutrientFloat(v, "lactose")
-				if err != nil {
-					return nil, err
-				}
-				serving.LactoseG = f
-			case "Maltose (g)":
-				f, err := parseNutrientFloat(v, "maltose")
-				if err != nil {
-					return nil, err
-				}
-				serving.MaltoseG = f
-			case "Starch (g)":
-				f, err := parseNutrientFloat(v, "starch")
-				if err != nil {
-					return nil, err
-				}
-				serving.StarchG = f
-			case "Sucrose (g)":
-				f, err := parseNutrientFloat(v, "sucrose")
-				if err != nil {
-					return nil, err
-				}
-				serving.SucroseG = f
-			case "Sugars (g)":
-				f, err := parseNutrientFloat(v, "sugars")
-				if err != nil {
-					return nil, err
-				}
-				serving.SugarsG = f
-			case "Net Carbs (g)":
-				f, err := parseNutrientFloat(v, "net carbs")
-				if err != nil {
-					return nil, err
-				}
-				serving.NetCarbsG = f
-			case "Fat (g)":
-				f, err := parseNutrientFloat(v, "fat")
-				if err != nil {
-					return nil, err
-				}
-				serving.FatG = f
-			case "Cholesterol (mg)":
-				f, err := parseNutrientFloat(v, "cholesterol")
-				if err != nil {
-					return nil, err
-				}
-				serving.CholesterolMg = f
-			case "Monounsaturated (g)":
-				f, err := parseNutrientFloat(v, "monounsaturated fat")
-				if err != nil {
-					return nil, err
-				}
-				serving.MonounsaturatedG = f
-			case "Polyunsaturated (g)":
-				f, err := parseNutrientFloat(v, "polyunsaturated fat")
-				if err != nil {
-					return nil, err
-				}
-				serving.PolyunsaturatedG = f
-			case "Saturated (g)":
-				f, err := parseNutrientFloat(v, "saturated fat")
-				if err != nil {
-					return nil, err
-				}
-				serving.SaturatedG = f
-			case "Trans-Fats (g)":
-				f, err := parseNutrientFloat(v, "trans fat")
-				if err != nil {
-					return nil, err
-				}
-				serving.TransFatG = f
-			case "Omega-3 (g)":
-				f, err := parseNutrientFloat(v, "omega-3")
-				if err != nil {
-					return nil, err
-				}
-				serving.Omega3G = f
-			case "Omega-6 (g)":
-				f, err := parseNutrientFloat(v, "omega-6")
-				if err != nil {
-					return nil, err
-				}
-				serving.Omega6G = f
-			case "Cystine (g)":
-				f, err := parseNutrientFloat(v, "cystine")
-				if err != nil {
-					return nil, err
-				}
-				serving.CystineG = f
-			case "Histidine (g)":
-				f, err := parseNutrientFloat(v, "histidine")
-				if err != nil {
-					return nil, err
-				}
-				serving.HistidineG = f
-			case "Isoleucine (g)":
-				f, err := parseNutrientFloat(v, "isoleucine")
-				if err != nil {
-					return nil, err
-				}
-				serving.IsoleucineG = f
-			case "Leucine (g)":
-				f, err := parseNutrientFloat(v, "leucine")
-				if err != nil {
-					return nil, err
-				}
-				serving.LeucineG = f
-			case "Lysine (g)":
-				f, err := parseNutrientFloat(v, "lysine")
-				if err != nil {
-					return nil, err
-				}
-				serving.LysineG = f
-			case "Methionine (g)":
-				f, err := parseNutrientFloat(v, "methionine")
-				if err != nil {
-					return nil, err
-				}
-				serving.MethionineG = f
-			case "Phenylalanine (g)":
-				f, err := parseNutrientFloat(v, "phenylalanine")
-				if err != nil {
-					return nil, err
-				}
-				serving.PhenylalanineG = f
-			case "Protein (g)":
-				f, err := parseNutrientFloat(v, "protein")
-				if err != nil {
-					return nil, err
-				}
-				serving.ProteinG = f
-			case "Threonine (g)":
-				f, err := parseNutrientFloat(v, "threonine")
-				if err != nil {
-					return nil, err
-				}
-				serving.ThreonineG = f
-			case "Tryptophan (g)":
-				f, err := parseNutrientFloat(v, "tryptophan")
-				if err != nil {
-					return nil, err
-				}
-				serving.TryptophanG = f
-			case "Tyrosine (g)":
-				f, err := parseNutrientFloat(v, "tyrosine")
-				if err != nil {
-					return nil, err
-				}
-				serving.TyrosineG = f
-			case "Valine (g)":
-				f, err := parseNutrientFloat(v, "valine")
-				if err != nil {
-					return nil, err
-				}
-				serving.ValineG = f
-			case "Alcohol (g)":
-				f, err := parseNutrientFloat(v, "alcohol")
-				if err != nil {
-					return nil, err
-				}
-				serving.AlcoholG = f
-			case "Category":
-				serving.Category = v
-			default:
-				fmt.Fprintf(os.Stderr, "Unknown category: %s\n", columnName)
-			}
+		records = append(records, record)
+	}
+
+	if len(rowErrs) > 0 {
+		return records, rowErrs
+	}
+
+	return records, nil
+}
 
+// dayCache holds the last date parsed, as the rows of an export are grouped by day.
+type dayCache struct {
+	date string
+	day  Date
+}
+
+// parse parses the date, reusing the last date when it is the same.
+func (c *dayCache) parse(date string) (Date, error) {
+	if date != "" && date == c.date {
+		return c.day, nil
+	}
+
+	day, err := ParseDate(date)
+	if err != nil {
+		return Date{}, err
+	}
+	c.date, c.day = date, day
+	return day, nil
+}
+
+// parseRowInfo parses the date and time of the row on line lineNum.
+func (o *ParseOptions) parseRowInfo(days *dayCache, date string, timeStr string, location *time.Location, lineNum int) (rowInfo, *RowError) {
+	day, err := days.parse(date)
+	if err != nil {
+		return rowInfo{}, &RowError{Row: lineNum, Column: "Day", Value: date, Err: err}
+	}
+
+	recordedTime, err := o.dayTime(day, timeStr, location)
+	if err != nil {
+		return rowInfo{}, &RowError{Row: lineNum, Column: "Time", Value: timeStr, Err: err}
+	}
+
+	hasTime := strings.TrimSpace(timeStr) != ""
+	if hasTime && o.DayStart > 0 {
+		day = DayOf(recordedTime, o.DayStart)
+	}
+
+	return rowInfo{row: lineNum, day: day, hasTime: hasTime, recordedTime: recordedTime}, nil
+}
+
+// ParseServings parses the raw CSV servings export configured by the options provided.
+func ParseServings(rawCSVReader io.Reader, options ...ParseOption) (ServingRecords, error) {
+	opts := newParseOptions(options...)
+
+	return parseExport(rawCSVReader, opts, opts.servingColumn,
+		func(serving *ServingRecord, info rowInfo) {
+			serving.Day, serving.HasTime, serving.RecordedTime, serving.Raw = info.day, info.hasTime, info.recordedTime, info.raw
+		})
+}
+
+// ParseServingsExport parses the raw CSV servings export setting the recorded times to location. It is equivalent to
+// ParseServings with WithLocation.
+func ParseServingsExport(rawCSVReader io.Reader, location *time.Location) (ServingRecords, error) {
+	return ParseServings(rawCSVReader, WithLocation(location))
+}
+
+// ParseServingsExportWithOptions parses the raw CSV servings export using opts. If opts is nil the default values are
+// utilized. It is equivalent to ParseServings with WithParseOptions and WithLocation.
+func ParseServingsExportWithOptions(rawCSVReader io.Reader, location *time.Location, opts *ParseOptions) (ServingRecords, error) {
+	return ParseServings(rawCSVReader, WithParseOptions(opts), WithLocation(location))
+}
+
+// servingColumn returns the parser of the servings column with the header. Unknown columns are reported on stderr and
+// ignored.
+func (o *ParseOptions) servingColumn(header string) columnParser[ServingRecord] {
+	if handler, ok := o.ServingColumnHandlers[header]; ok {
+		return handler
+	}
+
+	switch header {
+	case "Group":
+		return func(serving *ServingRecord, v string) error {
+			serving.Group = v
+			return nil
 		}
-		if timeStr == "" {
-			timeStr = "00:00"
+	case "Food Name":
+		return func(serving *ServingRecord, v string) error {
+			serving.FoodName = v
+			return nil
 		}
-
-		if location == nil {
-			location = time.UTC
+	case "Source":
+		return func(serving *ServingRecord, v string) error {
+			serving.Source = v
+			return nil
+		}
+	case "Food ID":
+		return func(serving *ServingRecord, v string) error {
+			serving.FoodID = v
+			return nil
 		}
+	case "Category":
+		return func(serving *ServingRecord, v string) error {
+			serving.Category = v
+			return nil
+		}
+	case "Amount":
+		return o.parseAmount
+	}
 
-		serving.RecordedTime, err = parseDateTime(date, timeStr, location)
-		if err != nil {
-			return nil, fmt.Errorf("parsing serving time: %w", err)
+	if n, ok := nutrientsByHeader[header]; ok {
+		return func(serving *ServingRecord, v string) error {
+			f, err := o.parseNutrientFloat(v, n.Name)
+			if err != nil {
+				return err
+			}
+			n.Set(serving, f)
+			return nil
 		}
-		servings = append(servings, serving)
 	}
 
-	return servings, nil
+	fmt.Fprintf(os.Stderr, "Unknown category: %s\n", header)
+	return nil
+}
 
+// parseAmount sets the quantity of the serving to the amount v, formatted as "value unit".
+func (o *ParseOptions) parseAmount(serving *ServingRecord, v string) error {
+	value, units, ok := strings.Cut(v, " ")
+	if !ok {
+		return fmt.Errorf("invalid amount format %q, expected 'value unit'", v)
+	}
+	f, err := o.parseFloat(value)
+	if err != nil {
+		return fmt.Errorf("parsing quantity value %q: %w", value, err)
+	}
+	serving.QuantityValue = f
+	serving.QuantityUnits = units
+	return nil
 }
 
 // parseFloat wraps time.ParseFloat but interprites an empty string as 0.
@@ -579,6 +651,9 @@ func parseFloat(s string, bitSize int) (float64, error) {
 
 type ExerciseRecord struct {
 	RecordedTime   time.Time
+	Day            Date
+	HasTime        bool
+	Raw            *RawRow
 	Exercise       string
 	Minutes        float64
 	CaloriesBurned float64
@@ -586,83 +661,64 @@ type ExerciseRecord struct {
 
 type ExerciseRecords []ExerciseRecord
 
-func ParseExerciseExport(rawCSVReader io.Reader, location *time.Location) (ExerciseRecords, error) {
-
-	r := csv.NewReader(rawCSVReader)
+// ParseExercises parses the raw CSV exercises export configured by the options provided.
+func ParseExercises(rawCSVReader io.Reader, options ...ParseOption) (ExerciseRecords, error) {
+	opts := newParseOptions(options...)
 
-	lineNum := 0
-	headers := make(map[int]string)
-	exercises := make(ExerciseRecords, 0, 0)
+	return parseExport(rawCSVReader, opts, opts.exerciseColumn,
+		func(exercise *ExerciseRecord, info rowInfo) {
+			exercise.Day, exercise.HasTime, exercise.RecordedTime, exercise.Raw = info.day, info.hasTime, info.recordedTime, info.raw
+		})
+}
 
-	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return nil, err
-		}
+// ParseExerciseExport parses the raw CSV exercises export setting the recorded times to location. It is equivalent to
+// ParseExercises with WithLocation.
+func ParseExerciseExport(rawCSVReader io.Reader, location *time.Location) (ExerciseRecords, error) {
+	return ParseExercises(rawCSVReader, WithLocation(location))
+}
 
-		// Index all the headers.
-		if lineNum == 0 {
+// ParseExerciseExportWithOptions parses the raw CSV exercises export using opts. If opts is nil the default values are
+// utilized. It is equivalent to ParseExercises with WithParseOptions and WithLocation.
+func ParseExerciseExportWithOptions(rawCSVReader io.Reader, location *time.Location, opts *ParseOptions) (ExerciseRecords, error) {
+	return ParseExercises(rawCSVReader, WithParseOptions(opts), WithLocation(location))
+}
 
-			for i, v := range record {
-				headers[i] = v
-			}
-			lineNum++
-			continue
+// exerciseColumn returns the parser of the exercises column with the header, or nil for unknown columns.
+func (o *ParseOptions) exerciseColumn(header string) columnParser[ExerciseRecord] {
+	switch header {
+	case "Exercise":
+		return func(exercise *ExerciseRecord, v string) error {
+			exercise.Exercise = v
+			return nil
 		}
-		lineNum++
-
-		var date string
-		var timeStr string
-		exercise := ExerciseRecord{}
-		for i, v := range record {
-			columnName := headers[i]
-
-			switch columnName {
-			case "Day":
-				date = v
-			case "Time":
-				timeStr = v
-			case "Exercise":
-				exercise.Exercise = v
-			case "Minutes":
-				f, err := parseFloat(v, 64)
-				if err != nil {
-					return nil, fmt.Errorf("parsing energy: %s", err)
-				}
-				exercise.Minutes = f
-
-			case "Calories Burned":
-				f, err := parseFloat(v, 64)
-				if err != nil {
-					return nil, fmt.Errorf("parsing caffeine: %s", err)
-				}
-				exercise.CaloriesBurned = f
-
+	case "Minutes":
+		return func(exercise *ExerciseRecord, v string) error {
+			f, err := o.parseFloat(v)
+			if err != nil {
+				return fmt.Errorf("parsing minutes: %w", err)
 			}
+			exercise.Minutes = f
+			return nil
 		}
-		if timeStr == "" {
-			timeStr = "00:00"
-		}
-
-		if location == nil {
-			location = time.UTC
-		}
-		exercise.RecordedTime, err = parseDateTime(date, timeStr, location)
-		if err != nil {
-			return nil, fmt.Errorf("parsing exercise time: %w", err)
+	case "Calories Burned":
+		return func(exercise *ExerciseRecord, v string) error {
+			f, err := o.parseFloat(v)
+			if err != nil {
+				return fmt.Errorf("parsing calories burned: %w", err)
+			}
+			exercise.CaloriesBurned = f
+			return nil
 		}
-		exercises = append(exercises, exercise)
 	}
 
-	return exercises, nil
-
+	return nil
 }
 
 type BiometricRecord struct {
 	RecordedTime time.Time
+	Day          Date
+	HasTime      bool
+	Raw          *RawRow
 	Metric       string
 	Unit         string
 	Amount       float64
@@ -670,79 +726,61 @@ type BiometricRecord struct {
 
 type BiometricRecords []BiometricRecord
 
-func ParseBiometricRecordsExport(rawCSVReader io.Reader, location *time.Location) (BiometricRecords, error) {
+// ParseBiometrics parses the raw CSV biometrics export configured by the options provided.
+func ParseBiometrics(rawCSVReader io.Reader, options ...ParseOption) (BiometricRecords, error) {
+	opts := newParseOptions(options...)
+
+	return parseExport(rawCSVReader, opts, opts.biometricColumn,
+		func(record *BiometricRecord, info rowInfo) {
+			record.Day, record.HasTime, record.RecordedTime, record.Raw = info.day, info.hasTime, info.recordedTime, info.raw
+		})
+}
 
-	r := csv.NewReader(rawCSVReader)
+// ParseBiometricRecordsExport parses the raw CSV biometrics export setting the recorded times to location. It is equivalent to
+// ParseBiometrics with WithLocation.
+func ParseBiometricRecordsExport(rawCSVReader io.Reader, location *time.Location) (BiometricRecords, error) {
+	return ParseBiometrics(rawCSVReader, WithLocation(location))
+}
 
-	lineNum := 0
-	headers := make(map[int]string)
-	records := make(BiometricRecords, 0, 0)
+// ParseBiometricRecordsExportWithOptions parses the raw CSV biometrics export using opts. If opts is nil the default values are
+// utilized. It is equivalent to ParseBiometrics with WithParseOptions and WithLocation.
+func ParseBiometricRecordsExportWithOptions(rawCSVReader io.Reader, location *time.Location, opts *ParseOptions) (BiometricRecords, error) {
+	return ParseBiometrics(rawCSVReader, WithParseOptions(opts), WithLocation(location))
+}
 
-	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
+// biometricColumn returns the parser of the biometrics column with the header, or nil for unknown columns.
+func (o *ParseOptions) biometricColumn(header string) columnParser[BiometricRecord] {
+	switch header {
+	case "Metric":
+		return func(record *BiometricRecord, v string) error {
+			record.Metric = v
+			return nil
 		}
-		if err != nil {
-			return nil, err
+	case "Unit":
+		return func(record *BiometricRecord, v string) error {
+			record.Unit = v
+			return nil
 		}
-
-		// Index all the headers.
-		if lineNum == 0 {
-
-			for i, v := range record {
-				headers[i] = v
+	case "Amount":
+		return func(record *BiometricRecord, v string) error {
+			// Amounts such as blood pressure, "120/80", are not numbers and are left at zero.
+			if strings.Contains(v, "/") {
+				return nil
 			}
-			lineNum++
-			continue
-		}
-		lineNum++
-
-		var date string
-		var timeStr string
-		bioRecord := BiometricRecord{}
-		for i, v := range record {
-			columnName := headers[i]
-
-			switch columnName {
-			case "Day":
-				date = v
-			case "Time":
-				timeStr = v
-			case "Metric":
-				bioRecord.Metric = v
-			case "Unit":
-				bioRecord.Unit = v
-			case "Amount":
-				if !strings.Contains(v, "/") {
-					f, err := parseFloat(v, 64)
-					if err != nil {
-						return nil, fmt.Errorf("parsing energy: %s", err)
-					}
-					bioRecord.Amount = f
-				}
+			f, err := o.parseFloat(v)
+			if err != nil {
+				return fmt.Errorf("parsing amount: %w", err)
 			}
+			record.Amount = f
+			return nil
 		}
-		if timeStr == "" {
-			timeStr = "00:00"
-		}
-
-		if location == nil {
-			location = time.UTC
-		}
-		bioRecord.RecordedTime, err = parseDateTime(date, timeStr, location)
-		if err != nil {
-			return nil, fmt.Errorf("parsing biometric time: %w", err)
-		}
-		records = append(records, bioRecord)
 	}
 
-	return records, nil
-
+	return nil
 }
 
-func parseNutrientFloat(value, nutrient string) (float64, error) {
-	f, err := parseFloat(value, 64)
+func (o *ParseOptions) parseNutrientFloat(value, nutrient string) (float64, error) {
+	f, err := o.parseFloat(value)
 	if err != nil {
 		return 0, fmt.Errorf("parsing %s value %q: %w", nutrient, value, err)
 	}
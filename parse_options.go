@@ -0,0 +1,266 @@
+package gocronometer
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// ParseOptions represents the options that can be provided to the parsers. Zero values revert to the library defaults.
+type ParseOptions struct {
+	// DecimalSeparator is the character separating the integer and fractional parts of numbers. Exports generated
+	// with some locales use a comma, for example "1,5". Defaults to a period.
+	DecimalSeparator rune
+
+	// GroupingSeparator is the character separating groups of thousands in numbers, for example "1,234.5". Groups
+	// are only accepted when every group after the first has exactly three digits. Defaults to a comma, or a period
+	// when DecimalSeparator is a comma.
+	GroupingSeparator rune
+
+	// DefaultTime is the time of day, as an offset from midnight, given to records without a time. Records are only
+	// timed when the user has enabled timestamps in Cronometer. As the time zone conversion of a midnight time can
+	// place a record on the previous calendar day, DefaultTimeNoon is often a safer choice. Records given the
+	// default time have HasTime set to false. Defaults to midnight.
+	DefaultTime time.Duration
+
+	// Location is the location the recorded times are set to. Defaults to UTC.
+	Location *time.Location
+
+	// HeaderMapping maps the headers found in the export to the headers understood by the parser, for example
+	// {"Énergie (kcal)": "Energy (kcal)"}. It allows renamed or localized headers to populate the right fields.
+	HeaderMapping map[string]string
+
+	// ServingColumnHandlers are called to parse the columns of a servings export with a matching header, taking the
+	// place of the built-in handling. The header is matched after HeaderMapping is applied, allowing columns the
+	// parser does not understand to populate a field.
+	ServingColumnHandlers map[string]func(serving *ServingRecord, value string) error
+
+	// KeepRaw keeps the raw CSV row each record was parsed from in the Raw field of the record. It allows values of
+	// columns the parser does not understand to be recovered, at the cost of the memory to retain every row.
+	KeepRaw bool
+
+	// Lenient continues parsing when a row fails to parse instead of aborting. The rows that fail are skipped and
+	// returned as RowErrors along with the records that were parsed successfully.
+	Lenient bool
+
+	// Progress receives the number of rows parsed as the export is read, including rows that fail to parse.
+	// Progress is not reported when nil.
+	Progress Progress
+
+	// MaxBytes is the most bytes read from an export, after it is decompressed, bounding the memory used by exports
+	// uploaded by untrusted users. Exports are not limited when zero.
+	MaxBytes int64
+
+	// MaxRecords is the most rows an export may have, excluding the header. Exports are not limited when zero.
+	MaxRecords int
+
+	// MaxFieldLength is the most bytes a field of an export may have. Fields are not limited when zero.
+	MaxFieldLength int
+
+	// Columns restricts parsing to the columns with these headers, matched after HeaderMapping is applied. Nutrients
+	// may also be given by name, such as "Protein". The fields of other columns are left at their zero values without
+	// being converted, which speeds up workloads only needing a few of the nutrients. The Day and Time columns are
+	// always parsed. Every column is parsed when empty.
+	Columns []string
+
+	// Workers is the number of goroutines converting the rows of an export while another goroutine reads them,
+	// cutting the time to parse large exports such as those of "Export All Data". The records are returned in the
+	// order of the export. ServingColumnHandlers must be safe for concurrent use when set. Exports are parsed on the
+	// calling goroutine when zero or one.
+	Workers int
+
+	// FastCSV reads the export with a reader splitting lines on commas, which is faster than encoding/csv for the
+	// mostly unquoted exports of Cronometer. Records containing quotes are still read by encoding/csv.
+	FastCSV bool
+
+	// DSTPolicy resolves the times repeated or skipped by a daylight saving transition in Location. Defaults to
+	// DSTBefore.
+	DSTPolicy DSTPolicy
+
+	// DayStart is the time of day, as an offset from midnight, at which days start, for users that shifted the day
+	// boundary in Cronometer. Records with a time before it are given the previous Day, as DayOf, so late night snacks
+	// are totaled with the evening before them. Defaults to midnight.
+	DayStart time.Duration
+}
+
+// The following are common values for ParseOptions.DefaultTime.
+const (
+	DefaultTimeMidnight = time.Duration(0)
+	DefaultTimeNoon     = 12 * time.Hour
+	DefaultTimeEndOfDay = 23*time.Hour + 59*time.Minute + 59*time.Second
+)
+
+// ParseOption configures the ParseOptions used by a parser.
+type ParseOption func(*ParseOptions)
+
+// newParseOptions builds the ParseOptions from the defaults and the options provided.
+func newParseOptions(options ...ParseOption) *ParseOptions {
+	opts := &ParseOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	return opts
+}
+
+// WithParseOptions replaces all the options with a copy of opts. If opts is nil the default values are utilized. It
+// is intended for callers that build a ParseOptions value directly and is best given before any other options. The
+// maps and slices of opts are copied, so the options given after it do not modify opts, which can be reused by
+// concurrent parses.
+func WithParseOptions(opts *ParseOptions) ParseOption {
+	return func(o *ParseOptions) {
+		if opts == nil {
+			*o = ParseOptions{}
+			return
+		}
+		*o = *opts
+		o.HeaderMapping = maps.Clone(opts.HeaderMapping)
+		o.ServingColumnHandlers = maps.Clone(opts.ServingColumnHandlers)
+		o.Columns = slices.Clone(opts.Columns)
+	}
+}
+
+// WithLocation sets the location of every recorded time to the location provided.
+func WithLocation(location *time.Location) ParseOption {
+	return func(o *ParseOptions) {
+		o.Location = location
+	}
+}
+
+// WithDecimalSeparator sets ParseOptions.DecimalSeparator.
+func WithDecimalSeparator(separator rune) ParseOption {
+	return func(o *ParseOptions) {
+		o.DecimalSeparator = separator
+	}
+}
+
+// WithGroupingSeparator sets ParseOptions.GroupingSeparator.
+func WithGroupingSeparator(separator rune) ParseOption {
+	return func(o *ParseOptions) {
+		o.GroupingSeparator = separator
+	}
+}
+
+// WithDefaultTime sets ParseOptions.DefaultTime.
+func WithDefaultTime(defaultTime time.Duration) ParseOption {
+	return func(o *ParseOptions) {
+		o.DefaultTime = defaultTime
+	}
+}
+
+// WithKeepRaw enables ParseOptions.KeepRaw.
+func WithKeepRaw() ParseOption {
+	return func(o *ParseOptions) {
+		o.KeepRaw = true
+	}
+}
+
+// WithLenient enables ParseOptions.Lenient.
+func WithLenient() ParseOption {
+	return func(o *ParseOptions) {
+		o.Lenient = true
+	}
+}
+
+// WithHeaderMapping adds the mappings to ParseOptions.HeaderMapping.
+func WithHeaderMapping(mapping map[string]string) ParseOption {
+	return func(o *ParseOptions) {
+		if o.HeaderMapping == nil {
+			o.HeaderMapping = make(map[string]string, len(mapping))
+		}
+		for from, to := range mapping {
+			o.HeaderMapping[from] = to
+		}
+	}
+}
+
+// WithServingColumnHandler adds a handler to ParseOptions.ServingColumnHandlers for the header provided.
+func WithServingColumnHandler(header string, handler func(serving *ServingRecord, value string) error) ParseOption {
+	return func(o *ParseOptions) {
+		if o.ServingColumnHandlers == nil {
+			o.ServingColumnHandlers = make(map[string]func(serving *ServingRecord, value string) error)
+		}
+		o.ServingColumnHandlers[header] = handler
+	}
+}
+
+// WithProgress reports the number of rows parsed to progress.
+func WithProgress(progress Progress) ParseOption {
+	return func(o *ParseOptions) {
+		o.Progress = progress
+	}
+}
+
+// WithMaxBytes sets ParseOptions.MaxBytes.
+func WithMaxBytes(n int64) ParseOption {
+	return func(o *ParseOptions) {
+		o.MaxBytes = n
+	}
+}
+
+// WithMaxRecords sets ParseOptions.MaxRecords.
+func WithMaxRecords(n int) ParseOption {
+	return func(o *ParseOptions) {
+		o.MaxRecords = n
+	}
+}
+
+// WithMaxFieldLength sets ParseOptions.MaxFieldLength.
+func WithMaxFieldLength(n int) ParseOption {
+	return func(o *ParseOptions) {
+		o.MaxFieldLength = n
+	}
+}
+
+// WithColumns adds the headers to ParseOptions.Columns, parsing only those columns.
+//
+//	servings, err := gocronometer.ParseServings(r, gocronometer.WithColumns("Food Name", "Energy (kcal)", "Protein"))
+func WithColumns(headers ...string) ParseOption {
+	return func(o *ParseOptions) {
+		o.Columns = append(o.Columns, headers...)
+	}
+}
+
+// WithWorkers sets ParseOptions.Workers, for example to runtime.GOMAXPROCS(0).
+func WithWorkers(n int) ParseOption {
+	return func(o *ParseOptions) {
+		o.Workers = n
+	}
+}
+
+// WithFastCSV sets ParseOptions.FastCSV.
+func WithFastCSV() ParseOption {
+	return func(o *ParseOptions) {
+		o.FastCSV = true
+	}
+}
+
+// WithDSTPolicy sets ParseOptions.DSTPolicy.
+func WithDSTPolicy(policy DSTPolicy) ParseOption {
+	return func(o *ParseOptions) {
+		o.DSTPolicy = policy
+	}
+}
+
+// WithDayStart sets ParseOptions.DayStart.
+func WithDayStart(dayStart time.Duration) ParseOption {
+	return func(o *ParseOptions) {
+		o.DayStart = dayStart
+	}
+}
+
+// projection returns the set of headers of the columns to parse, or nil when every column is parsed.
+func (o *ParseOptions) projection() map[string]bool {
+	if len(o.Columns) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]bool, len(o.Columns))
+	for _, c := range o.Columns {
+		headers[c] = true
+		if n, ok := LookupNutrient(c); ok {
+			headers[n.Header] = true
+		}
+	}
+	return headers
+}
@@ -0,0 +1,141 @@
+package gocronometer
+
+import (
+	"sync"
+)
+
+// parallelBatchSize is the number of rows handed to a worker of a parallel parse at a time.
+const parallelBatchSize = 512
+
+// rowBatch is a batch of consecutive rows of an export converted by a worker of a parallel parse.
+type rowBatch[T any] struct {
+	lineNum int
+	rows    [][]string
+
+	// readErrs are the rows that could not be read in lenient mode, by their index in rows, which is nil for them.
+	readErrs map[int]*RowError
+
+	// fatal is the error that stopped the read after the rows of the batch.
+	fatal error
+
+	records []T
+	rowErrs RowErrors
+	done    chan struct{}
+}
+
+// parseParallel reads the rows after the headers on one goroutine and converts them in batches on opts.Workers
+// goroutines. The batches are collected in the order they were read, so the records and errors returned are the same
+// as those of parseSequential.
+func (p *exportParser[T]) parseParallel(r rowReader) ([]T, error) {
+	workers := p.opts.Workers
+	work := make(chan *rowBatch[T], workers)
+	ordered := make(chan *rowBatch[T], 2*workers)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var days dayCache
+			for b := range work {
+				p.parseBatch(b, &days)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(ordered)
+		defer close(work)
+		p.readBatches(r, work, ordered, stop)
+	}()
+
+	records := make([]T, 0)
+	var rowErrs RowErrors
+	for b := range ordered {
+		<-b.done
+		if !p.lenient && len(b.rowErrs) > 0 {
+			return nil, b.rowErrs[0]
+		}
+		records = append(records, b.records...)
+		rowErrs = append(rowErrs, b.rowErrs...)
+
+		if b.fatal != nil {
+			return nil, b.fatal
+		}
+	}
+	wg.Wait()
+
+	if len(rowErrs) > 0 {
+		return records, rowErrs
+	}
+
+	return records, nil
+}
+
+// readBatches reads the rows of the export into batches, sending each to work to be converted and to ordered to be
+// collected, until the end of the export, a fatal error or stop is closed.
+func (p *exportParser[T]) readBatches(r rowReader, work chan<- *rowBatch[T], ordered chan<- *rowBatch[T], stop <-chan struct{}) {
+	lineNum := 2
+	for {
+		b := &rowBatch[T]{lineNum: lineNum, rows: make([][]string, 0, parallelBatchSize), done: make(chan struct{})}
+
+		end := false
+		for len(b.rows) < parallelBatchSize {
+			row, err := p.next(r, lineNum)
+			if rowErr, ok := err.(*RowError); ok && p.lenient {
+				if b.readErrs == nil {
+					b.readErrs = make(map[int]*RowError)
+				}
+				b.readErrs[len(b.rows)] = rowErr
+			} else if err != nil {
+				b.fatal = err
+			}
+			if b.fatal != nil || (row == nil && err == nil) {
+				end = true
+				break
+			}
+			b.rows = append(b.rows, row)
+			lineNum++
+		}
+
+		select {
+		case work <- b:
+		case <-stop:
+			return
+		}
+		select {
+		case ordered <- b:
+		case <-stop:
+			return
+		}
+
+		if end {
+			return
+		}
+	}
+}
+
+// parseBatch converts the rows of the batch, stopping at the first error unless in lenient mode.
+func (p *exportParser[T]) parseBatch(b *rowBatch[T], days *dayCache) {
+	defer close(b.done)
+
+	b.records = make([]T, 0, len(b.rows))
+	for i, row := range b.rows {
+		if rowErr, ok := b.readErrs[i]; ok {
+			b.rowErrs = append(b.rowErrs, rowErr)
+			continue
+		}
+
+		record, rowErr := p.parseRow(row, b.lineNum+i, days)
+		if rowErr != nil {
+			b.rowErrs = append(b.rowErrs, rowErr)
+			if !p.lenient {
+				return
+			}
+			continue
+		}
+		b.records = append(b.records, record)
+	}
+}
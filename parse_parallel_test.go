@@ -0,0 +1,100 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/burke/gocronometer"
+)
+
+// biometricsExport builds an export of weights with an invalid amount on the rows in bad.
+func biometricsExport(rows int, bad map[int]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("Day,Time,Metric,Unit,Amount\n")
+	for d := 0; d < rows; d++ {
+		day := gocronometer.Date{Year: 2015, Month: time.January, Day: 1}.AddDays(d)
+		amount := fmt.Sprintf("%.1f", 80+float64(d%20)/10)
+		if v, ok := bad[d+2]; ok {
+			amount = v
+		}
+		fmt.Fprintf(&buf, "%s,07:30,Weight,kg,%s\n", day, amount)
+	}
+	return buf.Bytes()
+}
+
+func TestParseServings_Workers(t *testing.T) {
+	export := benchmarkServings(t)
+
+	sequential, err := gocronometer.ParseServings(bytes.NewReader(export), gocronometer.WithKeepRaw())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parallel, err := gocronometer.ParseServings(bytes.NewReader(export), gocronometer.WithKeepRaw(), gocronometer.WithWorkers(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !parallel.Equal(sequential) {
+		t.Fatalf("expected the parallel parse to match the sequential parse")
+	}
+
+	last := parallel[len(parallel)-1].Raw
+	if v, ok := last.Get("Food Name"); !ok || v != sequential[len(sequential)-1].FoodName {
+		t.Fatalf("expected the raw row to be kept but found %q", v)
+	}
+}
+
+func TestParseBiometrics_WorkersLenient(t *testing.T) {
+	export := biometricsExport(2000, map[int]string{10: "heavy", 700: "x", 1501: "?"})
+	export = append(export, "2020-06-01,07:30,Weight,\"kg\"x,80\n"...)
+
+	_, sequentialErr := gocronometer.ParseBiometrics(bytes.NewReader(export), gocronometer.WithLenient())
+	records, err := gocronometer.ParseBiometrics(bytes.NewReader(export), gocronometer.WithLenient(), gocronometer.WithWorkers(3))
+
+	var sequentialErrs, rowErrs gocronometer.RowErrors
+	if !errors.As(sequentialErr, &sequentialErrs) || !errors.As(err, &rowErrs) {
+		t.Fatalf("expected row errors but found %v and %v", sequentialErr, err)
+	}
+
+	if len(records) != 1997 || len(rowErrs) != 4 {
+		t.Fatalf("expected 1997 records and 4 errors but found %d and %d", len(records), len(rowErrs))
+	}
+	for i := range rowErrs {
+		if rowErrs[i].Error() != sequentialErrs[i].Error() {
+			t.Fatalf("expected error %d to be %q but found %q", i, sequentialErrs[i], rowErrs[i])
+		}
+	}
+}
+
+func TestParseBiometrics_WorkersStrict(t *testing.T) {
+	export := biometricsExport(2000, map[int]string{700: "x", 1501: "?"})
+
+	_, err := gocronometer.ParseBiometrics(bytes.NewReader(export), gocronometer.WithWorkers(3))
+
+	var rowErr *gocronometer.RowError
+	if !errors.As(err, &rowErr) || rowErr.Row != 700 {
+		t.Fatalf("expected the first invalid row to fail the parse but found %v", err)
+	}
+
+	_, err = gocronometer.ParseBiometrics(bytes.NewReader(export), gocronometer.WithWorkers(3), gocronometer.WithMaxRecords(100))
+	if !errors.Is(err, gocronometer.ErrLimitExceeded) {
+		t.Fatalf("expected the limit to be exceeded but found %v", err)
+	}
+}
+
+func BenchmarkParseServings_Workers(b *testing.B) {
+	export := benchmarkServings(b)
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := gocronometer.ParseServings(bytes.NewReader(export), gocronometer.WithWorkers(4)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
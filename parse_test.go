@@ -0,0 +1,476 @@
+package gocronometer_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseServingsExportWithOptions_DecimalSeparator(t *testing.T) {
+	raw := "Day,Time,Group,Food Name,Amount,Energy (kcal),Protein (g)\n" +
+		"2021-06-01,08:00,Breakfast,Eggs,\"1,5 large\",\"107,3\",\"9,4\"\n"
+
+	servings, err := gocronometer.ParseServingsExportWithOptions(strings.NewReader(raw), time.UTC, &gocronometer.ParseOptions{
+		DecimalSeparator: ',',
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(servings) != 1 {
+		t.Fatalf("expected 1 serving but found %d", len(servings))
+	}
+
+	s := servings[0]
+	if s.QuantityValue != 1.5 || s.QuantityUnits != "large" || s.EnergyKcal != 107.3 || s.ProteinG != 9.4 {
+		t.Fatalf("unexpected serving values: %+v", s)
+	}
+}
+
+func TestParseServingsExport_DecimalCommaFails(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,08:00,Eggs,1 large,\"107,3\"\n"
+
+	if _, err := gocronometer.ParseServingsExport(strings.NewReader(raw), time.UTC); err == nil {
+		t.Fatalf("expected an error parsing a decimal comma without the option set")
+	}
+}
+
+func TestParseServingsExport_GroupingSeparator(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Sodium (mg),Potassium (mg)\n" +
+		"2021-06-01,08:00,Broth,1 cup,\"1,234.5\",\"12,345\"\n"
+
+	servings, err := gocronometer.ParseServingsExport(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if servings[0].SodiumMg != 1234.5 || servings[0].PotassiumMg != 12345 {
+		t.Fatalf("unexpected serving values: %+v", servings[0])
+	}
+}
+
+func TestParseServingsExportWithOptions_GroupingSeparatorDecimalComma(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Sodium (mg)\n" +
+		"2021-06-01,08:00,Broth,1 cup,\"1.234,5\"\n"
+
+	servings, err := gocronometer.ParseServingsExportWithOptions(strings.NewReader(raw), time.UTC, &gocronometer.ParseOptions{
+		DecimalSeparator: ',',
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if servings[0].SodiumMg != 1234.5 {
+		t.Fatalf("expected sodium of 1234.5 but found %f", servings[0].SodiumMg)
+	}
+}
+
+func TestParseServingsExport_InvalidGrouping(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Sodium (mg)\n" +
+		"2021-06-01,08:00,Broth,1 cup,\"1,23\"\n"
+
+	if _, err := gocronometer.ParseServingsExport(strings.NewReader(raw), time.UTC); err == nil {
+		t.Fatalf("expected an error for an invalid digit grouping")
+	}
+}
+
+func TestParseExerciseExport_TimeFormats(t *testing.T) {
+	raw := "Day,Time,Exercise,Minutes,Calories Burned\n" +
+		"2021-06-01,07:05,Walking,30,120\n" +
+		"2021-06-01,1:30 PM,Cycling,60,500\n" +
+		"2021-06-01,9:15:30 pm,Yoga,20,60\n" +
+		"2021-06-01,,Stretching,10,20\n"
+
+	exercises, err := gocronometer.ParseExerciseExport(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []time.Time{
+		time.Date(2021, 6, 1, 7, 5, 0, 0, time.UTC),
+		time.Date(2021, 6, 1, 13, 30, 0, 0, time.UTC),
+		time.Date(2021, 6, 1, 21, 15, 30, 0, time.UTC),
+		time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for i, e := range expected {
+		if !exercises[i].RecordedTime.Equal(e) {
+			t.Fatalf("expected %s at index %d but found %s", e, i, exercises[i].RecordedTime)
+		}
+	}
+}
+
+func TestParseExerciseExport_InvalidTime(t *testing.T) {
+	raw := "Day,Time,Exercise,Minutes,Calories Burned\n" +
+		"2021-06-01,25:00,Walking,30,120\n"
+
+	if _, err := gocronometer.ParseExerciseExport(strings.NewReader(raw), time.UTC); err == nil {
+		t.Fatalf("expected an error for an invalid time")
+	}
+}
+
+func TestParseBiometricRecordsExportWithOptions_DefaultTime(t *testing.T) {
+	raw := "Day,Metric,Unit,Amount\n" +
+		"2021-06-01,Weight,kg,80.5\n"
+
+	records, err := gocronometer.ParseBiometricRecordsExportWithOptions(strings.NewReader(raw), time.UTC, &gocronometer.ParseOptions{
+		DefaultTime: gocronometer.DefaultTimeNoon,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	if !records[0].RecordedTime.Equal(expected) {
+		t.Fatalf("expected %s but found %s", expected, records[0].RecordedTime)
+	}
+}
+
+func TestParseServingsExport_HasTime(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount\n" +
+		"2021-06-01,00:00,Eggs,1 large\n" +
+		"2021-06-01,,Toast,1 slice\n"
+
+	servings, err := gocronometer.ParseServingsExport(strings.NewReader(raw), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !servings[0].HasTime {
+		t.Fatalf("expected the serving logged at midnight to have a time")
+	}
+
+	if servings[1].HasTime {
+		t.Fatalf("expected the serving without a time to not have a time")
+	}
+
+	if !servings[0].RecordedTime.Equal(servings[1].RecordedTime) {
+		t.Fatalf("expected both servings to be recorded at midnight")
+	}
+}
+
+func TestParseServingsExportWithOptions_Lenient(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,08:00,Eggs,1 large,72\n" +
+		"2021-06-01,09:00,Coffee,1 cup,abc\n" +
+		"2021-06-01,12:00,Salad,1 bowl,150\n"
+
+	servings, err := gocronometer.ParseServingsExportWithOptions(strings.NewReader(raw), time.UTC, &gocronometer.ParseOptions{
+		Lenient: true,
+	})
+
+	var rowErrs gocronometer.RowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected RowErrors but found %v", err)
+	}
+
+	if len(rowErrs) != 1 || rowErrs[0].Row != 3 || rowErrs[0].Column != "Energy (kcal)" || rowErrs[0].Value != "abc" {
+		t.Fatalf("unexpected row errors: %v", rowErrs)
+	}
+
+	if len(servings) != 2 || servings[0].FoodName != "Eggs" || servings[1].FoodName != "Salad" {
+		t.Fatalf("unexpected servings: %+v", servings)
+	}
+}
+
+func TestParseServingsExport_RowError(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount\n" +
+		"2021-06-01,08:00,Eggs,1 large\n" +
+		"June 1st,09:00,Coffee,1 cup\n"
+
+	servings, err := gocronometer.ParseServingsExport(strings.NewReader(raw), time.UTC)
+	if servings != nil {
+		t.Fatalf("expected no servings when not lenient")
+	}
+
+	var rowErr *gocronometer.RowError
+	if !errors.As(err, &rowErr) {
+		t.Fatalf("expected a RowError but found %v", err)
+	}
+
+	if rowErr.Row != 3 || rowErr.Column != "Day" {
+		t.Fatalf("unexpected row error: %s", rowErr)
+	}
+}
+
+func TestParseServings_Options(t *testing.T) {
+	raw := "Day,Food Name,Amount,Energy (kcal)\n" +
+		"2021-06-01,Eggs,1 large,\"72,5\"\n"
+
+	location := time.FixedZone("UTC-5", -5*60*60)
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw),
+		gocronometer.WithLocation(location),
+		gocronometer.WithDecimalSeparator(','),
+		gocronometer.WithDefaultTime(gocronometer.DefaultTimeNoon),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2021, 6, 1, 12, 0, 0, 0, location)
+	if !servings[0].RecordedTime.Equal(expected) || servings[0].RecordedTime.Location() != location {
+		t.Fatalf("expected %s but found %s", expected, servings[0].RecordedTime)
+	}
+
+	if servings[0].EnergyKcal != 72.5 {
+		t.Fatalf("expected energy of 72.5 but found %f", servings[0].EnergyKcal)
+	}
+}
+
+func TestWithParseOptions_CopiesOptions(t *testing.T) {
+	raw := "Jour,Food Name,Energy (kcal),Protein (g),Notes\n" +
+		"2021-06-01,Eggs,143,12.6,brunch\n"
+
+	columns := make([]string, 1, 4)
+	columns[0] = "Food Name"
+	opts := &gocronometer.ParseOptions{HeaderMapping: map[string]string{"Jour": "Day"}, Columns: columns}
+
+	handled := false
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw),
+		gocronometer.WithParseOptions(opts),
+		gocronometer.WithHeaderMapping(map[string]string{"Energie": "Energy (kcal)"}),
+		gocronometer.WithColumns("Protein", "Notes"),
+		gocronometer.WithServingColumnHandler("Notes", func(*gocronometer.ServingRecord, string) error {
+			handled = true
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if servings[0].ProteinG != 12.6 || servings[0].EnergyKcal != 0 || !handled {
+		t.Fatalf("unexpected serving: %+v", servings[0])
+	}
+
+	// The options given after WithParseOptions do not leak into the reused options.
+	if len(opts.HeaderMapping) != 1 || opts.ServingColumnHandlers != nil || len(opts.Columns) != 1 || columns[:2][1] != "" {
+		t.Fatalf("expected the options to be unchanged but found %+v", opts)
+	}
+
+	servings, err = gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithParseOptions(opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if servings[0].ProteinG != 0 || servings[0].FoodName != "Eggs" {
+		t.Fatalf("expected only the food name to be parsed but found %+v", servings[0])
+	}
+}
+
+func TestParseServings_HeaderMapping(t *testing.T) {
+	raw := "Jour,Heure,Aliment,Quantité,Énergie (kcal),Notes\n" +
+		"2021-06-01,08:00,Oeufs,2 large,143,brunch\n"
+
+	var notes string
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw),
+		gocronometer.WithHeaderMapping(map[string]string{
+			"Jour":           "Day",
+			"Heure":          "Time",
+			"Aliment":        "Food Name",
+			"Quantité":       "Amount",
+			"Énergie (kcal)": "Energy (kcal)",
+		}),
+		gocronometer.WithServingColumnHandler("Notes", func(serving *gocronometer.ServingRecord, value string) error {
+			notes = value
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := servings[0]
+	if s.FoodName != "Oeufs" || s.QuantityValue != 2 || s.EnergyKcal != 143 || !s.HasTime {
+		t.Fatalf("unexpected serving values: %+v", s)
+	}
+
+	if notes != "brunch" {
+		t.Fatalf("expected the handler to receive the notes but found %q", notes)
+	}
+}
+
+func TestParseServings_Columns(t *testing.T) {
+	raw := "Day,Time,Group,Food Name,Amount,Energy (kcal),Protein (g),Fat (g),Mystery\n" +
+		"2021-06-01,08:00,Breakfast,Eggs,2 large,143,12.6,9.5,x\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw),
+		gocronometer.WithColumns("Food Name", "Energy (kcal)", "Protein"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := servings[0]
+	if s.FoodName != "Eggs" || s.EnergyKcal != 143 || s.ProteinG != 12.6 || !s.HasTime {
+		t.Fatalf("expected the projected columns to be parsed: %+v", s)
+	}
+
+	if s.Group != "" || s.QuantityValue != 0 || s.FatG != 0 {
+		t.Fatalf("expected the other columns to be skipped: %+v", s)
+	}
+
+	// Values of the skipped columns are not converted, so they cannot fail the parse.
+	invalid := strings.Replace(raw, "9.5", "lots", 1)
+	if _, err := gocronometer.ParseServings(strings.NewReader(invalid), gocronometer.WithColumns("Energy (kcal)")); err != nil {
+		t.Fatalf("expected the invalid skipped column to be ignored: %s", err)
+	}
+}
+
+func TestParseServings_NewerNutrients(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Oxalate (mg),Lycopene (µg),Beta-carotene (µg),Glycine (g)\n" +
+		"2021-06-01,12:00,Spinach,1 cup,291,0,1688,0.04\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := servings[0]
+	if s.OxalateMg != 291 || s.LycopeneUg != 0 || s.BetaCaroteneUg != 1688 || s.GlycineG != 0.04 {
+		t.Fatalf("unexpected serving values: %+v", s)
+	}
+}
+
+func TestParseServings_Sugars(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Sugars (g),Added Sugars (g),Sugar Alcohols (g)\n" +
+		"2021-06-01,15:00,Protein Bar,1 bar,3,2,8\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := servings[0]
+	if s.SugarsG != 3 || s.AddedSugarsG != 2 || s.SugarAlcoholsG != 8 {
+		t.Fatalf("unexpected serving values: %+v", s)
+	}
+}
+
+func TestParseServings_Source(t *testing.T) {
+	raw := "Day,Time,Food Name,Source,Food ID,Amount\n" +
+		"2021-06-01,12:00,\"Spinach, Raw\",NCCDB,1234,1 cup\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if servings[0].Source != "NCCDB" || servings[0].FoodID != "1234" {
+		t.Fatalf("unexpected serving values: %+v", servings[0])
+	}
+
+	headers, err := gocronometer.ReadHeaders(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report := gocronometer.ValidateServingsHeaders(headers); len(report.Unrecognized) != 0 {
+		t.Fatalf("expected the source columns to be recognized but found %q", report.Unrecognized)
+	}
+}
+
+func TestParseServings_KeepRaw(t *testing.T) {
+	raw := "Day,Time,Food Name,Amount,Energy (kcal),Brand Notes\n" +
+		"2021-06-01,08:00,Eggs,1 large,72,free range\n"
+
+	servings, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithKeepRaw())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := servings[0].Raw
+	if row == nil {
+		t.Fatalf("expected the raw row to be kept")
+	}
+
+	if v, ok := row.Get("Brand Notes"); !ok || v != "free range" {
+		t.Fatalf("expected the unmodeled column to be recoverable but found %q", v)
+	}
+
+	if v, ok := row.Get("Energy (kcal)"); !ok || v != "72" {
+		t.Fatalf("expected the raw energy value but found %q", v)
+	}
+
+	servings, err = gocronometer.ParseServings(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if servings[0].Raw != nil {
+		t.Fatalf("expected the raw row to not be kept by default")
+	}
+}
+
+// benchmarkServings returns a servings export of a year of five servings a day with every column.
+func benchmarkServings(b testing.TB) []byte {
+	servings := make(gocronometer.ServingRecords, 0, 365*5)
+	start := gocronometer.Date{Year: 2021, Month: time.January, Day: 1}
+	for d := 0; d < 365; d++ {
+		for i := 0; i < 5; i++ {
+			day := start.AddDays(d)
+			s := gocronometer.ServingRecord{
+				Day: day, HasTime: true, RecordedTime: day.Time(time.UTC).Add(time.Duration(7+3*i) * time.Hour),
+				Group: "Breakfast", FoodName: "Eggs, Scrambled", Source: "NCCDB", QuantityValue: 2, QuantityUnits: "large",
+			}
+			for j, n := range gocronometer.Nutrients() {
+				n.Set(&s, float64(j)+0.25)
+			}
+			servings = append(servings, s)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gocronometer.WriteServingsCSV(&buf, servings); err != nil {
+		b.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkParseServings(b *testing.B) {
+	export := benchmarkServings(b)
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := gocronometer.ParseServings(bytes.NewReader(export)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseServings_Columns(b *testing.B) {
+	export := benchmarkServings(b)
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := gocronometer.ParseServings(bytes.NewReader(export),
+			gocronometer.WithColumns("Food Name", "Energy (kcal)", "Protein", "Carbs", "Fat"))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseBiometrics(b *testing.B) {
+	var buf bytes.Buffer
+	buf.WriteString("Day,Time,Metric,Unit,Amount\n")
+	for d := 0; d < 5000; d++ {
+		day := gocronometer.Date{Year: 2010, Month: time.January, Day: 1}.AddDays(d)
+		fmt.Fprintf(&buf, "%s,07:30,Weight,kg,%.1f\n", day, 80+float64(d%20)/10)
+	}
+	export := buf.Bytes()
+	b.SetBytes(int64(len(export)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := gocronometer.ParseBiometrics(bytes.NewReader(export)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
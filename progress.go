@@ -0,0 +1,83 @@
+package gocronometer
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// Progress receives progress reports from long operations, such as backfilling several years of exports, so command
+// line tools can show progress bars. It is accepted by the Client, the parsers and the syncer of the cronsync
+// package. Implementations must be safe for concurrent use as parallel exports report from several goroutines.
+type Progress interface {
+	// Downloaded reports the number of bytes of an export downloaded so far. The export is the type requested from
+	// Cronometer, for example "servings".
+	Downloaded(export string, bytes int64)
+
+	// ChunkCompleted reports that completed of the total chunks of an operation are done.
+	ChunkCompleted(completed int, total int)
+
+	// Parsed reports the number of rows of an export parsed so far.
+	Parsed(rows int)
+}
+
+// ProgressFuncs implements Progress with optional functions, so only the reports of interest need to be handled.
+// Nil functions are ignored.
+type ProgressFuncs struct {
+	DownloadedFunc     func(export string, bytes int64)
+	ChunkCompletedFunc func(completed int, total int)
+	ParsedFunc         func(rows int)
+}
+
+// Downloaded implements Progress.
+func (p ProgressFuncs) Downloaded(export string, bytes int64) {
+	if p.DownloadedFunc != nil {
+		p.DownloadedFunc(export, bytes)
+	}
+}
+
+// ChunkCompleted implements Progress.
+func (p ProgressFuncs) ChunkCompleted(completed int, total int) {
+	if p.ChunkCompletedFunc != nil {
+		p.ChunkCompletedFunc(completed, total)
+	}
+}
+
+// Parsed implements Progress.
+func (p ProgressFuncs) Parsed(rows int) {
+	if p.ParsedFunc != nil {
+		p.ParsedFunc(rows)
+	}
+}
+
+// progressReader reports the bytes read from the reader as downloaded.
+type progressReader struct {
+	r        io.Reader
+	export   string
+	progress Progress
+	read     int64
+}
+
+// Read implements io.Reader.
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.read += int64(n)
+		p.progress.Downloaded(p.export, p.read)
+	}
+	return n, err
+}
+
+// chunkCounter reports chunks as they complete.
+type chunkCounter struct {
+	progress  Progress
+	total     int
+	completed int32
+}
+
+// done records a completed chunk.
+func (c *chunkCounter) done() {
+	if c.progress == nil {
+		return
+	}
+	c.progress.ChunkCompleted(int(atomic.AddInt32(&c.completed, 1)), c.total)
+}
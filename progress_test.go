@@ -0,0 +1,26 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestWithProgress(t *testing.T) {
+	raw := "Day,Time,Food Name,Energy (kcal)\n" +
+		"2021-06-01,08:00,Eggs,140\n" +
+		"2021-06-01,12:00,Soup,200\n"
+
+	var reports []int
+	progress := gocronometer.ProgressFuncs{ParsedFunc: func(rows int) {
+		reports = append(reports, rows)
+	}}
+
+	if _, err := gocronometer.ParseServings(strings.NewReader(raw), gocronometer.WithProgress(progress)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reports) != 2 || reports[1] != 2 {
+		t.Fatalf("expected a report for each row but found %v", reports)
+	}
+}
@@ -0,0 +1,45 @@
+package gocronometer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces the start of requests by an interval. The zero value is ready to use.
+type rateLimiter struct {
+	mu   sync.Mutex
+	next time.Time // Earliest time the next request may start.
+}
+
+// wait blocks until a request may start, reserving its slot so concurrent callers are spaced by the interval. An
+// interval of zero or less never blocks. The error of the context is returned if it is done before the slot.
+func (l *rateLimiter) wait(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package gocronometer_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// roundTripperFunc serves requests without a network.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_RequestInterval(t *testing.T) {
+	client := gocronometer.NewClient(&gocronometer.ClientOptions{RequestInterval: 50 * time.Millisecond})
+	client.HTTPClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`//OK["token"]`)),
+			Header:     http.Header{},
+			Request:    req,
+		}, nil
+	})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GenerateAuthToken(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("expected the requests to be spaced by the interval but they took %s", elapsed)
+	}
+}
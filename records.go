@@ -0,0 +1,187 @@
+package gocronometer
+
+import (
+	"sort"
+	"time"
+)
+
+// Record is the constraint satisfied by the records of the servings, exercises and biometrics exports.
+type Record interface {
+	ServingRecord | ExerciseRecord | BiometricRecord
+	Key() string
+
+	// recordedAt returns the RecordedTime of the record.
+	recordedAt() time.Time
+
+	// mergeKey returns the record normalized for comparison with the records of other exports, with the time in UTC
+	// and without the raw row.
+	mergeKey() any
+}
+
+// Records is a collection of any of the record types, providing the behaviors shared by ServingRecords,
+// ExerciseRecords and BiometricRecords for code that is generic over the record type. Go does not allow methods on an
+// instantiated generic type, so those remain types of their own to keep their methods, and the methods of Records are
+// repeated on each of them. They share the underlying slice type, so they convert to and from Records without copying:
+//
+//	breakfast := servings.Filter(func(s gocronometer.ServingRecord) bool {
+//		return s.Group == "Breakfast"
+//	})
+//	records := gocronometer.Records[gocronometer.ServingRecord](breakfast)
+type Records[T Record] []T
+
+// Filter returns the records for which keep returns true, in order.
+func (r Records[T]) Filter(keep func(T) bool) Records[T] {
+	filtered := make(Records[T], 0)
+	for _, record := range r {
+		if keep(record) {
+			filtered = append(filtered, record)
+		}
+	}
+
+	return filtered
+}
+
+// Sort sorts the records in place by less. The sort is stable so records with equal keys retain their relative order.
+func (r Records[T]) Sort(less func(a T, b T) bool) {
+	sort.SliceStable(r, func(i, j int) bool {
+		return less(r[i], r[j])
+	})
+}
+
+// SortByTime sorts the records in place by RecordedTime, earliest first.
+func (r Records[T]) SortByTime() {
+	sortByTime(r)
+}
+
+// GroupBy groups the records by the key returned from key as the GroupBy function. The keys may be of any comparable
+// type, such as a Date or a string, and GroupBy panics on others. The GroupBy function returns a map typed by the key.
+func (r Records[T]) GroupBy(key func(T) any) map[any]Records[T] {
+	return GroupBy(r, key)
+}
+
+// Merge combines the records with those of the other exports into a single collection sorted by time, handling the
+// duplicates as MergeServings.
+func (r Records[T]) Merge(others ...Records[T]) Records[T] {
+	return mergeSorted(append([]Records[T]{r}, others...))
+}
+
+// Dedupe returns the records without those equal to an earlier record, ignoring the location of RecordedTime and the
+// raw row. Unlike Merge this removes a food logged twice at the same time with the same amount, so it is intended for
+// collections that were concatenated rather than those of a single export.
+func (r Records[T]) Dedupe() Records[T] {
+	seen := make(map[any]bool, len(r))
+	deduped := make(Records[T], 0, len(r))
+	for _, record := range r {
+		k := record.mergeKey()
+		if !seen[k] {
+			seen[k] = true
+			deduped = append(deduped, record)
+		}
+	}
+
+	return deduped
+}
+
+// Filter returns the servings for which keep returns true, as Records.Filter.
+func (s ServingRecords) Filter(keep func(ServingRecord) bool) ServingRecords {
+	return ServingRecords(Records[ServingRecord](s).Filter(keep))
+}
+
+// Sort sorts the servings in place by less, as Records.Sort.
+func (s ServingRecords) Sort(less func(a ServingRecord, b ServingRecord) bool) {
+	Records[ServingRecord](s).Sort(less)
+}
+
+// GroupBy groups the servings by the key returned from key, as Records.GroupBy.
+func (s ServingRecords) GroupBy(key func(ServingRecord) any) map[any]ServingRecords {
+	return GroupBy(s, key)
+}
+
+// Merge combines the servings with those of the other exports, as Records.Merge.
+func (s ServingRecords) Merge(others ...ServingRecords) ServingRecords {
+	return mergeSorted(append([]ServingRecords{s}, others...))
+}
+
+// Dedupe returns the servings without those equal to an earlier one, as Records.Dedupe.
+func (s ServingRecords) Dedupe() ServingRecords {
+	return ServingRecords(Records[ServingRecord](s).Dedupe())
+}
+
+// Filter returns the exercises for which keep returns true, as Records.Filter.
+func (e ExerciseRecords) Filter(keep func(ExerciseRecord) bool) ExerciseRecords {
+	return ExerciseRecords(Records[ExerciseRecord](e).Filter(keep))
+}
+
+// Sort sorts the exercises in place by less, as Records.Sort.
+func (e ExerciseRecords) Sort(less func(a ExerciseRecord, b ExerciseRecord) bool) {
+	Records[ExerciseRecord](e).Sort(less)
+}
+
+// GroupBy groups the exercises by the key returned from key, as Records.GroupBy.
+func (e ExerciseRecords) GroupBy(key func(ExerciseRecord) any) map[any]ExerciseRecords {
+	return GroupBy(e, key)
+}
+
+// Merge combines the exercises with those of the other exports, as Records.Merge.
+func (e ExerciseRecords) Merge(others ...ExerciseRecords) ExerciseRecords {
+	return mergeSorted(append([]ExerciseRecords{e}, others...))
+}
+
+// Dedupe returns the exercises without those equal to an earlier one, as Records.Dedupe.
+func (e ExerciseRecords) Dedupe() ExerciseRecords {
+	return ExerciseRecords(Records[ExerciseRecord](e).Dedupe())
+}
+
+// Filter returns the biometrics for which keep returns true, as Records.Filter.
+func (b BiometricRecords) Filter(keep func(BiometricRecord) bool) BiometricRecords {
+	return BiometricRecords(Records[BiometricRecord](b).Filter(keep))
+}
+
+// Sort sorts the biometrics in place by less, as Records.Sort.
+func (b BiometricRecords) Sort(less func(a BiometricRecord, b BiometricRecord) bool) {
+	Records[BiometricRecord](b).Sort(less)
+}
+
+// GroupBy groups the biometrics by the key returned from key, as Records.GroupBy.
+func (b BiometricRecords) GroupBy(key func(BiometricRecord) any) map[any]BiometricRecords {
+	return GroupBy(b, key)
+}
+
+// Merge combines the biometrics with those of the other exports, as Records.Merge.
+func (b BiometricRecords) Merge(others ...BiometricRecords) BiometricRecords {
+	return mergeSorted(append([]BiometricRecords{b}, others...))
+}
+
+// Dedupe returns the biometrics without those equal to an earlier one, as Records.Dedupe.
+func (b BiometricRecords) Dedupe() BiometricRecords {
+	return BiometricRecords(Records[BiometricRecord](b).Dedupe())
+}
+
+// sortByTime sorts the records in place by RecordedTime, earliest first.
+func sortByTime[S ~[]T, T Record](s S) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].recordedAt().Before(s[j].recordedAt())
+	})
+}
+
+func (s ServingRecord) recordedAt() time.Time   { return s.RecordedTime }
+func (e ExerciseRecord) recordedAt() time.Time  { return e.RecordedTime }
+func (b BiometricRecord) recordedAt() time.Time { return b.RecordedTime }
+
+func (s ServingRecord) mergeKey() any {
+	s.RecordedTime = s.RecordedTime.UTC()
+	s.Raw = nil
+	return s
+}
+
+func (e ExerciseRecord) mergeKey() any {
+	e.RecordedTime = e.RecordedTime.UTC()
+	e.Raw = nil
+	return e
+}
+
+func (b BiometricRecord) mergeKey() any {
+	b.RecordedTime = b.RecordedTime.UTC()
+	b.Raw = nil
+	return b
+}
@@ -0,0 +1,102 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestRecords(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	at := func(hour int) time.Time {
+		return day.Time(time.UTC).Add(time.Duration(hour) * time.Hour)
+	}
+	servings := gocronometer.ServingRecords{
+		{Day: day, RecordedTime: at(12), Group: "Lunch", FoodName: "Salad", EnergyKcal: 200},
+		{Day: day, RecordedTime: at(8), Group: "Breakfast", FoodName: "Eggs", EnergyKcal: 143},
+		{Day: day, RecordedTime: at(8), Group: "Breakfast", FoodName: "Toast", EnergyKcal: 80},
+	}
+
+	records := gocronometer.Records[gocronometer.ServingRecord](servings)
+	breakfast := records.Filter(func(s gocronometer.ServingRecord) bool { return s.Group == "Breakfast" })
+	if len(breakfast) != 2 || breakfast[0].FoodName != "Eggs" {
+		t.Fatalf("unexpected filtered servings %+v", breakfast)
+	}
+
+	groups := records.GroupBy(func(s gocronometer.ServingRecord) any { return s.Group })
+	if len(groups["Breakfast"]) != 2 || len(groups["Lunch"]) != 1 {
+		t.Fatalf("unexpected groups %+v", groups)
+	}
+
+	// Keys may be of any comparable type.
+	days := records.GroupBy(func(s gocronometer.ServingRecord) any { return s.Day })
+	if len(days) != 1 || len(days[day]) != 3 {
+		t.Fatalf("unexpected groups by day %+v", days)
+	}
+
+	sorted := append(gocronometer.Records[gocronometer.ServingRecord](nil), records...)
+	sorted.Sort(func(a, b gocronometer.ServingRecord) bool { return a.EnergyKcal < b.EnergyKcal })
+	if sorted[0].FoodName != "Toast" || sorted[2].FoodName != "Salad" {
+		t.Fatalf("unexpected sorted servings %+v", sorted)
+	}
+
+	sorted.SortByTime()
+	if sorted[0].FoodName != "Toast" || sorted[1].FoodName != "Eggs" || sorted[2].FoodName != "Salad" {
+		t.Fatalf("expected a stable sort by time but found %+v", sorted)
+	}
+
+	// The collection converts back to the named type with its methods.
+	if total := gocronometer.ServingRecords(breakfast).Total(); total.EnergyKcal != 223 {
+		t.Fatalf("expected the breakfast total of 223 kcal but found %f", total.EnergyKcal)
+	}
+}
+
+func TestServingRecords_Records(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{
+		{Day: day, RecordedTime: day.Time(time.UTC).Add(12 * time.Hour), Group: "Lunch", FoodName: "Salad", EnergyKcal: 200},
+		{Day: day, RecordedTime: day.Time(time.UTC).Add(8 * time.Hour), Group: "Breakfast", FoodName: "Eggs", EnergyKcal: 143},
+	}
+
+	// The methods of Records are available on the named collections, returning the named collections.
+	breakfast := servings.Filter(func(s gocronometer.ServingRecord) bool { return s.Group == "Breakfast" })
+	if total := breakfast.Total(); total.EnergyKcal != 143 {
+		t.Fatalf("expected the breakfast total of 143 kcal but found %f", total.EnergyKcal)
+	}
+
+	if groups := servings.GroupBy(func(s gocronometer.ServingRecord) any { return s.Day }); len(groups[day]) != 2 {
+		t.Fatalf("unexpected groups %+v", groups)
+	}
+
+	merged := servings.Merge(breakfast)
+	if len(merged) != 2 || merged[0].FoodName != "Eggs" {
+		t.Fatalf("expected the merged servings sorted by time without duplicates but found %+v", merged)
+	}
+
+	if deduped := append(servings, breakfast...).Dedupe(); len(deduped) != 2 {
+		t.Fatalf("expected the duplicate to be removed but found %d", len(deduped))
+	}
+
+	servings.Sort(func(a, b gocronometer.ServingRecord) bool { return a.EnergyKcal < b.EnergyKcal })
+	if servings[0].FoodName != "Eggs" {
+		t.Fatalf("unexpected sorted servings %+v", servings)
+	}
+}
+
+func TestRecords_MergeDedupe(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	weight := gocronometer.BiometricRecord{Day: day, RecordedTime: day.Time(time.UTC), Metric: "Weight", Unit: "kg", Amount: 80}
+	local := weight
+	local.RecordedTime = weight.RecordedTime.In(time.FixedZone("UTC+0", 0))
+
+	first := gocronometer.Records[gocronometer.BiometricRecord]{weight, weight}
+	second := gocronometer.Records[gocronometer.BiometricRecord]{local}
+
+	if merged := first.Merge(second); len(merged) != 2 {
+		t.Fatalf("expected the duplicates within an export to be kept but found %d", len(merged))
+	}
+
+	if deduped := append(first, second...).Dedupe(); len(deduped) != 1 {
+		t.Fatalf("expected every duplicate to be removed but found %d", len(deduped))
+	}
+}
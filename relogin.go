@@ -0,0 +1,63 @@
+package gocronometer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultMaxRelogins is the number of times an export logs in again when the session has expired, unless configured
+// otherwise with MaxRelogins.
+const DefaultMaxRelogins = 1
+
+// maxRelogins returns the number of times to log in again, applying the default.
+func (c *Client) maxRelogins() int {
+	switch {
+	case c.MaxRelogins < 0:
+		return 0
+	case c.MaxRelogins == 0:
+		return DefaultMaxRelogins
+	default:
+		return c.MaxRelogins
+	}
+}
+
+// withRelogin calls fn, logging in again with the credentials of the last Login and retrying while fn returns
+// ErrSessionExpired, up to the limit of maxRelogins.
+func (c *Client) withRelogin(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		c.mu.RLock()
+		generation := c.generation
+		c.mu.RUnlock()
+
+		err := fn()
+		if err == nil || !errors.Is(err, ErrSessionExpired) || attempt >= c.maxRelogins() {
+			return err
+		}
+
+		c.debug(ctx, "session expired, logging in again", "attempt", attempt+1, "error", err)
+		if err := c.relogin(ctx, generation); err != nil {
+			return fmt.Errorf("failed to log in again after the session expired: %w", err)
+		}
+	}
+}
+
+// relogin logs in again with the credentials of the last Login, unless another goroutine already did since the
+// session of the generation.
+func (c *Client) relogin(ctx context.Context, generation int) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+
+	c.mu.RLock()
+	current, username, password := c.generation, c.username, c.password
+	c.mu.RUnlock()
+
+	if current != generation {
+		return nil
+	}
+	if username == "" {
+		return fmt.Errorf("the client has not logged in: %w", ErrSessionExpired)
+	}
+
+	return c.Login(ctx, username, password)
+}
@@ -0,0 +1,83 @@
+package gocronometer_test
+
+import (
+	"context"
+	"errors"
+	"github.com/burke/gocronometer"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// loginCronometer returns a client whose sessions expire after sessionTokens token generations, and the number of
+// logins made.
+func loginCronometer(opts *gocronometer.ClientOptions, sessionTokens int) (*gocronometer.Client, *int) {
+	var logins, tokens int
+	client := gocronometer.NewClient(opts)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Request: req}
+		body := ""
+		var reqBody []byte
+		if req.Body != nil {
+			reqBody, _ = io.ReadAll(req.Body)
+		}
+		switch {
+		case req.URL.String() == gocronometer.HTMLLoginURL:
+			body = `<html><body><form><input name="anticsrf" value="csrf"></form></body></html>`
+		case req.URL.String() == gocronometer.APILoginURL:
+			logins++
+			tokens = 0
+			resp.Header.Set("Set-Cookie", "sesnonce=nonce")
+			body = `{"success":true}`
+		case strings.Contains(string(reqBody), "|authenticate|"):
+			body = "//OK[42,1]"
+		case strings.Contains(string(reqBody), "generateAuthorizationToken"):
+			tokens++
+			if tokens > sessionTokens {
+				resp.StatusCode = http.StatusUnauthorized
+			}
+			body = `//OK[1,["token"],0,7]`
+		default:
+			body = "Day,Time,Food Name\n2021-06-01,08:00,Eggs\n"
+		}
+		resp.Body = io.NopCloser(strings.NewReader(body))
+		return resp
+	})
+	return client, &logins
+}
+
+func TestClient_Relogin(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	client, logins := loginCronometer(nil, 1)
+	if err := client.Login(ctx, "user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.ExportServings(ctx, day, day); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if *logins != 3 {
+		t.Fatalf("expected 3 logins but found %d", *logins)
+	}
+
+	client, _ = loginCronometer(&gocronometer.ClientOptions{MaxRelogins: -1}, 1)
+	if err := client.Login(ctx, "user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ExportServings(ctx, day, day); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ExportServings(ctx, day, day); !errors.Is(err, gocronometer.ErrSessionExpired) {
+		t.Fatalf("expected the session to expire without logging in again but found %v", err)
+	}
+
+	client, _ = loginCronometer(nil, 0)
+	if _, err := client.ExportServings(ctx, day, day); !errors.Is(err, gocronometer.ErrSessionExpired) {
+		t.Fatalf("expected the session to expire without credentials but found %v", err)
+	}
+}
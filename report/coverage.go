@@ -0,0 +1,94 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"strconv"
+)
+
+// Coverage is a matrix of the percentage of the target of each nutrient reached on each day, for rendering as a
+// heatmap to make chronic gaps in micronutrients visible.
+type Coverage struct {
+	// Days are every day of the range, earliest first, including days without servings.
+	Days []gocronometer.Date
+
+	// Nutrients are the nutrients with targets, sorted by name.
+	Nutrients []gocronometer.Nutrient
+
+	// Percent holds a row for each day with the percentage of the target of each nutrient, in the order of Nutrients.
+	// The row of a day without servings is nil, so it can be told apart from a day lacking every nutrient.
+	Percent [][]float64
+}
+
+// CoverageMatrix calculates the coverage of the targets on each day from start to end, inclusive. Servings outside of
+// the range are ignored, as are targets of unknown nutrients and targets of zero.
+func CoverageMatrix(start gocronometer.Date, end gocronometer.Date, servings gocronometer.ServingRecords, targets Targets) Coverage {
+	var coverage Coverage
+	for _, p := range targetProgress(targets, gocronometer.ServingRecord{}, 1) {
+		if p.Target != 0 {
+			coverage.Nutrients = append(coverage.Nutrients, p.Nutrient)
+		}
+	}
+
+	days := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) gocronometer.Date {
+		return s.Day
+	})
+	for day := start; !day.After(end); day = day.AddDays(1) {
+		coverage.Days = append(coverage.Days, day)
+
+		s, ok := days[day]
+		if !ok {
+			coverage.Percent = append(coverage.Percent, nil)
+			continue
+		}
+
+		total := s.Total()
+		row := make([]float64, len(coverage.Nutrients))
+		for i, n := range coverage.Nutrients {
+			row[i] = n.Value(total) / lookupTarget(targets, n) * 100
+		}
+		coverage.Percent = append(coverage.Percent, row)
+	}
+
+	return coverage
+}
+
+// lookupTarget returns the target of the nutrient, which may be keyed by its name or header.
+func lookupTarget(targets Targets, n gocronometer.Nutrient) float64 {
+	for name, target := range targets {
+		if found, ok := gocronometer.LookupNutrient(name); ok && found.Name == n.Name {
+			return target
+		}
+	}
+	return 0
+}
+
+// WriteCSV writes the matrix with a row for each day and a column for each nutrient, for plotting tools that read
+// CSV. The cells of days without servings are empty.
+func (c Coverage) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	headers := []string{"Day"}
+	for _, n := range c.Nutrients {
+		headers = append(headers, n.Name)
+	}
+	if err := cw.Write(headers); err != nil {
+		return fmt.Errorf("writing headers: %w", err)
+	}
+
+	for i, day := range c.Days {
+		row := make([]string, len(headers))
+		row[0] = day.String()
+		for j, percent := range c.Percent[i] {
+			row[j+1] = strconv.FormatFloat(percent, 'f', 1, 64)
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("writing %s: %w", day, err)
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
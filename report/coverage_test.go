@@ -0,0 +1,44 @@
+package report_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/report"
+	"testing"
+)
+
+func TestCoverageMatrix(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: date(1), IronMg: 9, VitaminCMg: 45},
+		{Day: date(1), IronMg: 9, VitaminCMg: 45},
+		{Day: date(3), IronMg: 4.5},
+		{Day: date(10), IronMg: 100},
+	}
+	targets := report.Targets{"Iron": 18, "Vitamin C (mg)": 90, "Unknown": 5, "Zinc": 0}
+
+	coverage := report.CoverageMatrix(date(1), date(3), servings, targets)
+	if len(coverage.Days) != 3 || len(coverage.Percent) != 3 {
+		t.Fatalf("expected a row for each of the 3 days but found %+v", coverage)
+	}
+	if len(coverage.Nutrients) != 2 || coverage.Nutrients[0].Name != "Iron" || coverage.Nutrients[1].Name != "Vitamin C" {
+		t.Fatalf("unexpected nutrients: %+v", coverage.Nutrients)
+	}
+	if coverage.Percent[0][0] != 100 || coverage.Percent[0][1] != 100 {
+		t.Fatalf("unexpected coverage of the first day: %v", coverage.Percent[0])
+	}
+	if coverage.Percent[1] != nil {
+		t.Fatalf("expected no row for the day without servings but found %v", coverage.Percent[1])
+	}
+	if coverage.Percent[2][0] != 25 || coverage.Percent[2][1] != 0 {
+		t.Fatalf("unexpected coverage of the last day: %v", coverage.Percent[2])
+	}
+
+	var buf bytes.Buffer
+	if err := coverage.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Day,Iron,Vitamin C\n2021-06-01,100.0,100.0\n2021-06-02,,\n2021-06-03,25.0,0.0\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q but found %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,120 @@
+package report
+
+import (
+	"fmt"
+	"github.com/burke/gocronometer"
+	"html/template"
+	"io"
+	"math"
+)
+
+// nutrientRow is a row of the nutrient table of a day.
+type nutrientRow struct {
+	Name   string
+	Amount float64
+	Unit   gocronometer.Unit
+}
+
+// nutrientRows lists the nutrients of the total with a non-zero amount, in the order of the servings export.
+func nutrientRows(total gocronometer.ServingRecord) []nutrientRow {
+	var rows []nutrientRow
+	for _, n := range gocronometer.Nutrients() {
+		if v := n.Value(total); v != 0 {
+			rows = append(rows, nutrientRow{Name: n.Name, Amount: v, Unit: n.Unit})
+		}
+	}
+	return rows
+}
+
+// progressWidth is the width of a progress bar as a percentage, capped at a full bar.
+func progressWidth(t TargetProgress) float64 {
+	return math.Min(math.Max(t.Percent(), 0), 100)
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"title":     periodTitle,
+	"macros":    macroRows,
+	"nutrients": nutrientRows,
+	"width":     progressWidth,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Nutrition Report</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: 0 auto; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; }
+td.number { text-align: right; }
+.bar { background: #eee; width: 12em; height: 1em; }
+.bar div { background: #4a8; height: 100%; }
+.bar div.over { background: #d84; }
+</style>
+</head>
+<body>
+{{- range .}}
+<section>
+<h1>Nutrition Summary: {{title .}}</h1>
+<h2>Macros</h2>
+<table>
+<tr><th>Macro</th><th>Amount (g)</th><th>Energy (kcal)</th><th>Share</th></tr>
+{{- range macros .}}
+<tr><td>{{.Name}}</td><td class="number">{{printf "%.1f" .Grams}}</td><td class="number">{{printf "%.0f" .Kcal}}</td><td class="number">{{printf "%.0f" .Share}}%</td></tr>
+{{- end}}
+</table>
+{{- if .Targets}}
+<h2>Targets</h2>
+{{template "targets" .Targets}}
+{{- end}}
+{{- if .TopFoods}}
+<h2>Top Foods</h2>
+<table>
+<tr><th>Food</th><th>Servings</th><th>Energy (kcal)</th></tr>
+{{- range .TopFoods}}
+<tr><td>{{.Name}}</td><td class="number">{{.Servings}}</td><td class="number">{{printf "%.0f" .EnergyKcal}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Biometrics}}
+<h2>Biometrics</h2>
+<table>
+<tr><th>Metric</th><th>First</th><th>Last</th><th>Change</th></tr>
+{{- range .Biometrics}}
+<tr><td>{{.Metric}}</td><td class="number">{{printf "%.1f" .First}} {{.Unit}}</td><td class="number">{{printf "%.1f" .Last}} {{.Unit}}</td><td class="number">{{printf "%+.1f" .Change}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Days}}
+<section>
+<h2>{{.Day}}</h2>
+{{- if .Targets}}
+{{template "targets" .Targets}}
+{{- end}}
+<table>
+<tr><th>Nutrient</th><th>Amount</th></tr>
+{{- range nutrients .Total}}
+<tr><td>{{.Name}}</td><td class="number">{{printf "%.1f" .Amount}} {{.Unit}}</td></tr>
+{{- end}}
+</table>
+</section>
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+{{define "targets"}}<table>
+<tr><th>Nutrient</th><th>Average</th><th>Target</th><th>Progress</th></tr>
+{{- range .}}
+<tr><td>{{.Nutrient.Name}}</td><td class="number">{{printf "%.1f" .Average}} {{.Nutrient.Unit}}</td><td class="number">{{printf "%.1f" .Target}} {{.Nutrient.Unit}}</td><td><div class="bar"><div{{if gt .Percent 100.0}} class="over"{{end}} style="width: {{printf "%.0f" (width .)}}%"></div></div> {{printf "%.0f" .Percent}}%</td></tr>
+{{- end}}
+</table>{{end}}`))
+
+// WriteHTML renders the summaries to w as a standalone HTML document, with a section for each summary holding its
+// macros, target progress bars, top foods and biometric changes, followed by a section for each day with the day's
+// target progress and the nutrients eaten.
+func WriteHTML(w io.Writer, summaries []Summary) error {
+	if err := htmlTemplate.Execute(w, summaries); err != nil {
+		return fmt.Errorf("writing html report: %s", err)
+	}
+	return nil
+}
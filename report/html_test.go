@@ -0,0 +1,34 @@
+package report_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/report"
+	"strings"
+	"testing"
+)
+
+func TestWriteHTML(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: date(1), FoodName: "<Toast>", EnergyKcal: 150, ProteinG: 5},
+		{Day: date(2), FoodName: "Steak", EnergyKcal: 500, ProteinG: 50},
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteHTML(&buf, report.Weekly(servings, nil, report.Targets{"Protein": 25})); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"<h1>Nutrition Summary: 2021-05-31 to 2021-06-06</h1>",
+		"<h2>2021-06-01</h2>",
+		"<td>&lt;Toast&gt;</td>",
+		`<div style="width: 20%"></div></div> 20%`,
+		`<div class="over" style="width: 100%"></div></div> 200%`,
+		`<tr><td>Energy</td><td class="number">500.0 kcal</td></tr>`,
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected the report to contain %q but found:\n%s", expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown renders the summaries to w as Markdown, with a section for each summary. Sections are omitted when
+// the summary has nothing to show in them.
+func WriteMarkdown(w io.Writer, summaries []Summary) error {
+	bw := bufio.NewWriter(w)
+
+	for i, s := range summaries {
+		if i > 0 {
+			fmt.Fprintln(bw)
+		}
+
+		fmt.Fprintf(bw, "# Nutrition Summary: %s\n\n", periodTitle(s))
+
+		fmt.Fprintln(bw, "## Macros")
+		fmt.Fprintln(bw)
+		fmt.Fprintln(bw, "| Macro | Amount (g) | Energy (kcal) | Share |")
+		fmt.Fprintln(bw, "| --- | ---: | ---: | ---: |")
+		for _, m := range macroRows(s) {
+			fmt.Fprintf(bw, "| %s | %.1f | %.0f | %.0f%% |\n", m.Name, m.Grams, m.Kcal, m.Share)
+		}
+		fmt.Fprintf(bw, "\nTotal energy: %.0f kcal\n", s.Total.EnergyKcal)
+
+		if len(s.Days) > 1 {
+			fmt.Fprintln(bw, "\n## Days")
+			fmt.Fprintln(bw)
+			fmt.Fprintln(bw, "| Day | Energy (kcal) | Protein (g) | Carbs (g) | Fat (g) |")
+			fmt.Fprintln(bw, "| --- | ---: | ---: | ---: | ---: |")
+			for _, d := range s.Days {
+				fmt.Fprintf(bw, "| %s | %.0f | %.1f | %.1f | %.1f |\n",
+					d.Day, d.Total.EnergyKcal, d.Total.ProteinG, d.Total.CarbsG, d.Total.FatG)
+			}
+		}
+
+		if len(s.TopFoods) > 0 {
+			fmt.Fprintln(bw, "\n## Top Foods")
+			fmt.Fprintln(bw)
+			fmt.Fprintln(bw, "| Food | Servings | Energy (kcal) |")
+			fmt.Fprintln(bw, "| --- | ---: | ---: |")
+			for _, f := range s.TopFoods {
+				fmt.Fprintf(bw, "| %s | %d | %.0f |\n", escapeMarkdown(f.Name), f.Servings, f.EnergyKcal)
+			}
+		}
+
+		if len(s.Targets) > 0 {
+			fmt.Fprintln(bw, "\n## Targets")
+			fmt.Fprintln(bw)
+			fmt.Fprintln(bw, "| Nutrient | Daily Average | Target | Progress |")
+			fmt.Fprintln(bw, "| --- | ---: | ---: | ---: |")
+			for _, t := range s.Targets {
+				fmt.Fprintf(bw, "| %s | %.1f %s | %.1f %s | %.0f%% |\n",
+					t.Nutrient.Name, t.Average, t.Nutrient.Unit, t.Target, t.Nutrient.Unit, t.Percent())
+			}
+		}
+
+		if len(s.Biometrics) > 0 {
+			fmt.Fprintln(bw, "\n## Biometrics")
+			fmt.Fprintln(bw)
+			fmt.Fprintln(bw, "| Metric | First | Last | Change |")
+			fmt.Fprintln(bw, "| --- | ---: | ---: | ---: |")
+			for _, b := range s.Biometrics {
+				fmt.Fprintf(bw, "| %s | %s | %s | %+.1f |\n",
+					escapeMarkdown(b.Metric), amount(b.First, b.Unit), amount(b.Last, b.Unit), b.Change())
+			}
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing markdown report: %s", err)
+	}
+	return nil
+}
+
+// periodTitle names the period of the summary.
+func periodTitle(s Summary) string {
+	if s.Start == s.End {
+		return s.Start.String()
+	}
+	return fmt.Sprintf("%s to %s", s.Start, s.End)
+}
+
+// macroRow is the energy of a macro and its share of the energy from macros.
+type macroRow struct {
+	Name  string
+	Grams float64
+	Kcal  float64
+	Share float64
+}
+
+// macroRows breaks the summary down into the energy of each macro.
+func macroRows(s Summary) []macroRow {
+	rows := []macroRow{
+		{Name: "Protein", Grams: s.Total.ProteinG, Kcal: s.Macros.ProteinKcal},
+		{Name: "Carbs", Grams: s.Total.CarbsG, Kcal: s.Macros.CarbsKcal},
+		{Name: "Fat", Grams: s.Total.FatG, Kcal: s.Macros.FatKcal},
+		{Name: "Alcohol", Grams: s.Total.AlcoholG, Kcal: s.Macros.AlcoholKcal},
+	}
+
+	if total := s.Macros.Total(); total > 0 {
+		for i := range rows {
+			rows[i].Share = rows[i].Kcal / total * 100
+		}
+	}
+	return rows
+}
+
+// amount formats an amount with its unit.
+func amount(v float64, unit string) string {
+	if unit == "" {
+		return fmt.Sprintf("%.1f", v)
+	}
+	return fmt.Sprintf("%.1f %s", v, unit)
+}
+
+// escapeMarkdown escapes the characters that would break a table cell.
+func escapeMarkdown(s string) string {
+	return strings.NewReplacer(`|`, `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,37 @@
+package report_test
+
+import (
+	"bytes"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/report"
+	"strings"
+	"testing"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: date(1), FoodName: "Salt | Pepper", EnergyKcal: 0},
+		{Day: date(1), FoodName: "Toast", EnergyKcal: 150, ProteinG: 5, CarbsG: 25, FatG: 2},
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf, report.Daily(servings, nil, report.Targets{"Energy": 2000})); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"# Nutrition Summary: 2021-06-01\n",
+		"| Protein | 5.0 | 20 | 14% |\n",
+		"| Toast | 1 | 150 |\n",
+		`| Salt \| Pepper | 1 | 0 |`,
+		"| Energy | 150.0 kcal | 2000.0 kcal | 8% |\n",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected the report to contain %q but found:\n%s", expected, buf.String())
+		}
+	}
+
+	if strings.Contains(buf.String(), "## Biometrics") || strings.Contains(buf.String(), "## Days") {
+		t.Fatalf("expected empty sections to be omitted but found:\n%s", buf.String())
+	}
+}
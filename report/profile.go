@@ -0,0 +1,135 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"io"
+	"sort"
+)
+
+// Range bounds the daily amount of a nutrient in the unit of the nutrient. A zero bound leaves the range unbounded
+// on that side, so a range with only Max is a limit, such as for sodium.
+type Range struct {
+	Min float64 `json:"min,omitempty"`
+	Max float64 `json:"max,omitempty"`
+}
+
+// Profile is a named set of daily nutrient ranges, allowing targets other than those of Cronometer, such as a profile
+// for training days and another for rest days. The ranges are keyed by the nutrient name or header as accepted by
+// gocronometer.LookupNutrient.
+type Profile struct {
+	Name   string           `json:"name"`
+	Ranges map[string]Range `json:"ranges"`
+}
+
+// Targets returns the minimums of the ranges as targets, so the profile can be used with Summarize, Daily, Weekly and
+// CoverageMatrix. Ranges with only a maximum use the maximum as their target.
+func (p Profile) Targets() Targets {
+	targets := Targets{}
+	for name, r := range p.Ranges {
+		if r.Min != 0 {
+			targets[name] = r.Min
+		} else if r.Max != 0 {
+			targets[name] = r.Max
+		}
+	}
+	return targets
+}
+
+// RangeStatus is the position of an amount relative to a Range.
+type RangeStatus int
+
+// The following are the positions of an amount relative to a Range.
+const (
+	WithinRange RangeStatus = iota
+	BelowRange
+	AboveRange
+)
+
+// String returns the name of the status.
+func (s RangeStatus) String() string {
+	switch s {
+	case BelowRange:
+		return "below"
+	case AboveRange:
+		return "above"
+	default:
+		return "within"
+	}
+}
+
+// RangeProgress is the daily average of a nutrient over a summary against its range.
+type RangeProgress struct {
+	Nutrient gocronometer.Nutrient
+	Range    Range
+	Average  float64
+	Status   RangeStatus
+}
+
+// Check compares the daily averages of the summary with the ranges of the profile, sorted by nutrient name. Ranges of
+// unknown nutrients are ignored, and summaries without days have no averages to compare.
+func (p Profile) Check(summary Summary) []RangeProgress {
+	if len(summary.Days) == 0 {
+		return nil
+	}
+
+	var progress []RangeProgress
+	for name, r := range p.Ranges {
+		n, ok := gocronometer.LookupNutrient(name)
+		if !ok {
+			continue
+		}
+
+		average := n.Value(summary.Total) / float64(len(summary.Days))
+		status := WithinRange
+		if r.Min != 0 && average < r.Min {
+			status = BelowRange
+		} else if r.Max != 0 && average > r.Max {
+			status = AboveRange
+		}
+		progress = append(progress, RangeProgress{Nutrient: n, Range: r, Average: average, Status: status})
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		return progress[i].Nutrient.Name < progress[j].Nutrient.Name
+	})
+	return progress
+}
+
+// LoadProfiles reads profiles from a JSON configuration holding a list of profiles, keyed by their name:
+//
+//	[
+//		{"name": "training", "ranges": {"Protein": {"min": 150}, "Sodium": {"max": 2300}}},
+//		{"name": "rest", "ranges": {"Protein": {"min": 120}, "Energy": {"max": 2200}}}
+//	]
+//
+// An error is returned for profiles without a name, duplicate names, unknown nutrients and ranges with a minimum
+// above their maximum, so mistakes in the configuration are found when it is loaded.
+func LoadProfiles(r io.Reader) (map[string]Profile, error) {
+	var list []Profile
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, fmt.Errorf("decoding profiles: %w", err)
+	}
+
+	profiles := make(map[string]Profile, len(list))
+	for _, p := range list {
+		if p.Name == "" {
+			return nil, fmt.Errorf("profile without a name")
+		}
+		if _, ok := profiles[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate profile %q", p.Name)
+		}
+		for name, rng := range p.Ranges {
+			if _, ok := gocronometer.LookupNutrient(name); !ok {
+				return nil, fmt.Errorf("profile %q: unknown nutrient %q", p.Name, name)
+			}
+			if rng.Max != 0 && rng.Min > rng.Max {
+				return nil, fmt.Errorf("profile %q: minimum of %q is above its maximum", p.Name, name)
+			}
+		}
+		profiles[p.Name] = p
+	}
+
+	return profiles, nil
+}
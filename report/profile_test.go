@@ -0,0 +1,68 @@
+package report_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/report"
+	"strings"
+	"testing"
+)
+
+func TestLoadProfiles(t *testing.T) {
+	profiles, err := report.LoadProfiles(strings.NewReader(`[
+		{"name": "training", "ranges": {"Protein": {"min": 150}, "Sodium (mg)": {"max": 2300}}},
+		{"name": "rest", "ranges": {"Protein": {"min": 120, "max": 160}}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	training, ok := profiles["training"]
+	if !ok || len(profiles) != 2 {
+		t.Fatalf("unexpected profiles: %+v", profiles)
+	}
+	targets := training.Targets()
+	if targets["Protein"] != 150 || targets["Sodium (mg)"] != 2300 {
+		t.Fatalf("unexpected targets: %v", targets)
+	}
+
+	for _, invalid := range []string{
+		`[{"ranges": {}}]`,
+		`[{"name": "a"}, {"name": "a"}]`,
+		`[{"name": "a", "ranges": {"Unobtainium": {"min": 1}}}]`,
+		`[{"name": "a", "ranges": {"Protein": {"min": 200, "max": 100}}}]`,
+		`{`,
+	} {
+		if _, err := report.LoadProfiles(strings.NewReader(invalid)); err == nil {
+			t.Fatalf("expected an error loading %s", invalid)
+		}
+	}
+}
+
+func TestProfile_Check(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: date(1), ProteinG: 100, SodiumMg: 3000, FiberG: 30},
+		{Day: date(2), ProteinG: 120, SodiumMg: 2000, FiberG: 30},
+	}
+	summary := report.Summarize(date(1), date(2), servings, nil, nil)
+
+	profile := report.Profile{Name: "training", Ranges: map[string]report.Range{
+		"Protein": {Min: 150},
+		"Sodium":  {Max: 2300},
+		"Fiber":   {Min: 25, Max: 40},
+	}}
+	progress := profile.Check(summary)
+	if len(progress) != 3 {
+		t.Fatalf("expected 3 ranges but found %+v", progress)
+	}
+
+	statuses := map[string]report.RangeStatus{}
+	for _, p := range progress {
+		statuses[p.Nutrient.Name] = p.Status
+	}
+	if statuses["Protein"] != report.BelowRange || statuses["Sodium"] != report.AboveRange || statuses["Fiber"] != report.WithinRange {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+	if progress[1].Nutrient.Name != "Protein" || progress[1].Average != 110 {
+		t.Fatalf("unexpected progress of protein: %+v", progress[1])
+	}
+}
@@ -0,0 +1,236 @@
+// Package report summarizes parsed Cronometer records over days and weeks and renders the summaries for sharing.
+package report
+
+import (
+	"github.com/burke/gocronometer"
+	"sort"
+	"strings"
+)
+
+// TopFoodsLimit is the number of foods listed in the top foods of a summary.
+const TopFoodsLimit = 5
+
+// Targets are the daily targets of nutrients, keyed by the nutrient name or header as accepted by
+// gocronometer.LookupNutrient. Targets are in the unit of the nutrient.
+type Targets map[string]float64
+
+// FoodTotal is the energy eaten of a food over a summary.
+type FoodTotal struct {
+	Name       string
+	Servings   int
+	EnergyKcal float64
+}
+
+// TargetProgress is the daily average of a nutrient over a summary against its target.
+type TargetProgress struct {
+	Nutrient gocronometer.Nutrient
+	Target   float64
+	Average  float64
+}
+
+// Percent is the daily average as a percentage of the target.
+func (t TargetProgress) Percent() float64 {
+	if t.Target == 0 {
+		return 0
+	}
+	return t.Average / t.Target * 100
+}
+
+// BiometricChange is the change of a metric over a summary, from its first to its last recording.
+type BiometricChange struct {
+	Metric string
+	Unit   string
+	First  float64
+	Last   float64
+}
+
+// Change is the difference between the last and the first recording.
+func (b BiometricChange) Change() float64 {
+	return b.Last - b.First
+}
+
+// DayTotal is the nutrition of a single day.
+type DayTotal struct {
+	Day     gocronometer.Date
+	Total   gocronometer.ServingRecord
+	Macros  gocronometer.MacroEnergy
+	Targets []TargetProgress // The averages are the totals of the day.
+}
+
+// Summary is the nutrition and biometrics over the days from Start to End, inclusive.
+type Summary struct {
+	Start      gocronometer.Date
+	End        gocronometer.Date
+	Days       []DayTotal // Days with servings, earliest first.
+	Total      gocronometer.ServingRecord
+	Macros     gocronometer.MacroEnergy
+	TopFoods   []FoodTotal
+	Targets    []TargetProgress
+	Biometrics []BiometricChange
+}
+
+// Summarize summarizes the servings and biometrics recorded from start to end, inclusive. Records outside of the
+// range are ignored. Target averages are over the days with servings, and targets of unknown nutrients are ignored.
+func Summarize(start gocronometer.Date, end gocronometer.Date, servings gocronometer.ServingRecords, biometrics gocronometer.BiometricRecords, targets Targets) Summary {
+	inRange := func(d gocronometer.Date) bool {
+		return !d.Before(start) && !d.After(end)
+	}
+
+	var periodServings gocronometer.ServingRecords
+	for _, s := range servings {
+		if inRange(s.Day) {
+			periodServings = append(periodServings, s)
+		}
+	}
+
+	var periodBiometrics gocronometer.BiometricRecords
+	for _, b := range biometrics {
+		if inRange(b.Day) {
+			periodBiometrics = append(periodBiometrics, b)
+		}
+	}
+
+	summary := Summary{
+		Start:      start,
+		End:        end,
+		Total:      periodServings.Total(),
+		Macros:     periodServings.MacroEnergy(),
+		TopFoods:   topFoods(periodServings),
+		Biometrics: biometricChanges(periodBiometrics),
+	}
+
+	days := gocronometer.GroupBy(periodServings, func(s gocronometer.ServingRecord) gocronometer.Date {
+		return s.Day
+	})
+	for day, s := range days {
+		summary.Days = append(summary.Days, DayTotal{Day: day, Total: s.Total(), Macros: s.MacroEnergy()})
+	}
+	sort.Slice(summary.Days, func(i, j int) bool {
+		return summary.Days[i].Day.Before(summary.Days[j].Day)
+	})
+
+	if len(summary.Days) > 0 {
+		summary.Targets = targetProgress(targets, summary.Total, len(summary.Days))
+	}
+	for i, d := range summary.Days {
+		summary.Days[i].Targets = targetProgress(targets, d.Total, 1)
+	}
+
+	return summary
+}
+
+// targetProgress averages the total over the days for each target, sorted by nutrient name.
+func targetProgress(targets Targets, total gocronometer.ServingRecord, days int) []TargetProgress {
+	var progress []TargetProgress
+	for name, target := range targets {
+		n, ok := gocronometer.LookupNutrient(name)
+		if !ok {
+			continue
+		}
+		progress = append(progress, TargetProgress{
+			Nutrient: n,
+			Target:   target,
+			Average:  n.Value(total) / float64(days),
+		})
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		return progress[i].Nutrient.Name < progress[j].Nutrient.Name
+	})
+	return progress
+}
+
+// Daily summarizes each day with servings or biometrics, earliest first.
+func Daily(servings gocronometer.ServingRecords, biometrics gocronometer.BiometricRecords, targets Targets) []Summary {
+	return summarizePeriods(servings, biometrics, targets, func(d gocronometer.Date) gocronometer.Date {
+		return d
+	}, 1)
+}
+
+// Weekly summarizes each week, starting on Monday, with servings or biometrics, earliest first.
+func Weekly(servings gocronometer.ServingRecords, biometrics gocronometer.BiometricRecords, targets Targets) []Summary {
+	return summarizePeriods(servings, biometrics, targets, func(d gocronometer.Date) gocronometer.Date {
+		// Weekday counts from Sunday so shift it to count from Monday.
+		offset := (int(d.Time(nil).Weekday()) + 6) % 7
+		return d.AddDays(-offset)
+	}, 7)
+}
+
+// summarizePeriods summarizes the periods of days long starting on the days returned by periodStart.
+func summarizePeriods(servings gocronometer.ServingRecords, biometrics gocronometer.BiometricRecords, targets Targets, periodStart func(gocronometer.Date) gocronometer.Date, days int) []Summary {
+	starts := map[gocronometer.Date]bool{}
+	for _, s := range servings {
+		starts[periodStart(s.Day)] = true
+	}
+	for _, b := range biometrics {
+		starts[periodStart(b.Day)] = true
+	}
+
+	ordered := make([]gocronometer.Date, 0, len(starts))
+	for start := range starts {
+		ordered = append(ordered, start)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Before(ordered[j])
+	})
+
+	summaries := make([]Summary, 0, len(ordered))
+	for _, start := range ordered {
+		summaries = append(summaries, Summarize(start, start.AddDays(days-1), servings, biometrics, targets))
+	}
+	return summaries
+}
+
+// topFoods totals the servings by food name and returns the TopFoodsLimit foods with the most energy.
+func topFoods(servings gocronometer.ServingRecords) []FoodTotal {
+	foods := gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) string {
+		return s.FoodName
+	})
+
+	totals := make([]FoodTotal, 0, len(foods))
+	for name, s := range foods {
+		totals = append(totals, FoodTotal{Name: name, Servings: len(s), EnergyKcal: s.Total().EnergyKcal})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].EnergyKcal != totals[j].EnergyKcal {
+			return totals[i].EnergyKcal > totals[j].EnergyKcal
+		}
+		return totals[i].Name < totals[j].Name
+	})
+
+	if len(totals) > TopFoodsLimit {
+		totals = totals[:TopFoodsLimit]
+	}
+	return totals
+}
+
+// biometricChanges finds the first and last recording of each metric and unit, sorted by metric.
+func biometricChanges(biometrics gocronometer.BiometricRecords) []BiometricChange {
+	sorted := append(gocronometer.BiometricRecords(nil), biometrics...)
+	sorted.SortByTime()
+
+	type metricKey struct {
+		metric string
+		unit   string
+	}
+
+	var (
+		changes []BiometricChange
+		index   = map[metricKey]int{}
+	)
+	for _, b := range sorted {
+		key := metricKey{metric: strings.ToLower(b.Metric), unit: strings.ToLower(b.Unit)}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(changes)
+			changes = append(changes, BiometricChange{Metric: b.Metric, Unit: b.Unit, First: b.Amount, Last: b.Amount})
+			continue
+		}
+		changes[i].Last = b.Amount
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Metric < changes[j].Metric
+	})
+	return changes
+}
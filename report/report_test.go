@@ -0,0 +1,59 @@
+package report_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/report"
+	"testing"
+	"time"
+)
+
+func date(day int) gocronometer.Date {
+	return gocronometer.Date{Year: 2021, Month: time.June, Day: day}
+}
+
+func TestWeekly(t *testing.T) {
+	// June 7th 2021 is a Monday.
+	servings := gocronometer.ServingRecords{
+		{Day: date(6), FoodName: "Toast", EnergyKcal: 150, ProteinG: 5},
+		{Day: date(7), FoodName: "Toast", EnergyKcal: 150, ProteinG: 5},
+		{Day: date(8), FoodName: "Steak", EnergyKcal: 500, ProteinG: 50},
+	}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: date(9), RecordedTime: date(9).Time(nil), Metric: "Weight", Unit: "kg", Amount: 80},
+		{Day: date(7), RecordedTime: date(7).Time(nil), Metric: "Weight", Unit: "kg", Amount: 81},
+	}
+
+	summaries := report.Weekly(servings, biometrics, report.Targets{"Protein": 55, "Unknown": 1})
+	if len(summaries) != 2 {
+		t.Fatalf("expected 2 weeks but found %d", len(summaries))
+	}
+
+	week := summaries[1]
+	if week.Start != date(7) || week.End != date(13) || len(week.Days) != 2 {
+		t.Fatalf("unexpected week: %+v", week)
+	}
+
+	if week.TopFoods[0].Name != "Steak" || week.TopFoods[1].Servings != 1 {
+		t.Fatalf("unexpected top foods: %+v", week.TopFoods)
+	}
+
+	if len(week.Targets) != 1 || week.Targets[0].Average != 27.5 || week.Targets[0].Percent() != 50 {
+		t.Fatalf("unexpected targets: %+v", week.Targets)
+	}
+
+	if len(week.Biometrics) != 1 || week.Biometrics[0].First != 81 || week.Biometrics[0].Change() != -1 {
+		t.Fatalf("unexpected biometrics: %+v", week.Biometrics)
+	}
+}
+
+func TestDaily(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{Day: date(2), FoodName: "Toast", EnergyKcal: 150},
+		{Day: date(1), FoodName: "Toast", EnergyKcal: 150},
+	}
+
+	summaries := report.Daily(servings, nil, nil)
+	if len(summaries) != 2 || summaries[0].Start != date(1) || summaries[0].End != date(1) {
+		t.Fatalf("unexpected summaries: %+v", summaries)
+	}
+}
@@ -0,0 +1,1211 @@
+// Protocol buffer definitions of the parsed Cronometer records and the gRPC service serving them. The Go code in
+// rpc/gocronometerpb is generated from this file with protoc-gen-go and protoc-gen-go-grpc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: gocronometer/v1/records.proto
+
+package gocronometerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Date is a civil date as shown in Cronometer, independent of any time zone.
+type Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Date) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Date) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *Date) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// ServingRecord is a serving of a food from the servings export.
+type ServingRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordedTime  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=recorded_time,json=recordedTime,proto3" json:"recorded_time,omitempty"`
+	Day           *Date                  `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	HasTime       bool                   `protobuf:"varint,3,opt,name=has_time,json=hasTime,proto3" json:"has_time,omitempty"`
+	Group         string                 `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	FoodName      string                 `protobuf:"bytes,5,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	FoodId        string                 `protobuf:"bytes,7,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	QuantityValue float64                `protobuf:"fixed64,8,opt,name=quantity_value,json=quantityValue,proto3" json:"quantity_value,omitempty"`
+	QuantityUnits string                 `protobuf:"bytes,9,opt,name=quantity_units,json=quantityUnits,proto3" json:"quantity_units,omitempty"`
+	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	// Amounts of the nutrients keyed by the Cronometer nutrient name, such as "Vitamin K", in the unit of the nutrient.
+	// Nutrients with an amount of zero are omitted.
+	Nutrients map[string]float64 `protobuf:"bytes,11,rep,name=nutrients,proto3" json:"nutrients,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *ServingRecord) Reset() {
+	*x = ServingRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServingRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServingRecord) ProtoMessage() {}
+
+func (x *ServingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServingRecord.ProtoReflect.Descriptor instead.
+func (*ServingRecord) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServingRecord) GetRecordedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTime
+	}
+	return nil
+}
+
+func (x *ServingRecord) GetDay() *Date {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *ServingRecord) GetHasTime() bool {
+	if x != nil {
+		return x.HasTime
+	}
+	return false
+}
+
+func (x *ServingRecord) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetFoodId() string {
+	if x != nil {
+		return x.FoodId
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetQuantityValue() float64 {
+	if x != nil {
+		return x.QuantityValue
+	}
+	return 0
+}
+
+func (x *ServingRecord) GetQuantityUnits() string {
+	if x != nil {
+		return x.QuantityUnits
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ServingRecord) GetNutrients() map[string]float64 {
+	if x != nil {
+		return x.Nutrients
+	}
+	return nil
+}
+
+// ExerciseRecord is an exercise from the exercises export.
+type ExerciseRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordedTime   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=recorded_time,json=recordedTime,proto3" json:"recorded_time,omitempty"`
+	Day            *Date                  `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	HasTime        bool                   `protobuf:"varint,3,opt,name=has_time,json=hasTime,proto3" json:"has_time,omitempty"`
+	Exercise       string                 `protobuf:"bytes,4,opt,name=exercise,proto3" json:"exercise,omitempty"`
+	Minutes        float64                `protobuf:"fixed64,5,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	CaloriesBurned float64                `protobuf:"fixed64,6,opt,name=calories_burned,json=caloriesBurned,proto3" json:"calories_burned,omitempty"`
+}
+
+func (x *ExerciseRecord) Reset() {
+	*x = ExerciseRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExerciseRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExerciseRecord) ProtoMessage() {}
+
+func (x *ExerciseRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExerciseRecord.ProtoReflect.Descriptor instead.
+func (*ExerciseRecord) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExerciseRecord) GetRecordedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTime
+	}
+	return nil
+}
+
+func (x *ExerciseRecord) GetDay() *Date {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *ExerciseRecord) GetHasTime() bool {
+	if x != nil {
+		return x.HasTime
+	}
+	return false
+}
+
+func (x *ExerciseRecord) GetExercise() string {
+	if x != nil {
+		return x.Exercise
+	}
+	return ""
+}
+
+func (x *ExerciseRecord) GetMinutes() float64 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *ExerciseRecord) GetCaloriesBurned() float64 {
+	if x != nil {
+		return x.CaloriesBurned
+	}
+	return 0
+}
+
+// BiometricRecord is a biometric from the biometrics export.
+type BiometricRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordedTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=recorded_time,json=recordedTime,proto3" json:"recorded_time,omitempty"`
+	Day          *Date                  `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	HasTime      bool                   `protobuf:"varint,3,opt,name=has_time,json=hasTime,proto3" json:"has_time,omitempty"`
+	Metric       string                 `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Unit         string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Amount       float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *BiometricRecord) Reset() {
+	*x = BiometricRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BiometricRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BiometricRecord) ProtoMessage() {}
+
+func (x *BiometricRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BiometricRecord.ProtoReflect.Descriptor instead.
+func (*BiometricRecord) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BiometricRecord) GetRecordedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTime
+	}
+	return nil
+}
+
+func (x *BiometricRecord) GetDay() *Date {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *BiometricRecord) GetHasTime() bool {
+	if x != nil {
+		return x.HasTime
+	}
+	return false
+}
+
+func (x *BiometricRecord) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *BiometricRecord) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *BiometricRecord) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Snapshot holds parsed records for storage, such as a binary snapshot of an export that can be read from other
+// languages.
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servings   []*ServingRecord   `protobuf:"bytes,1,rep,name=servings,proto3" json:"servings,omitempty"`
+	Exercises  []*ExerciseRecord  `protobuf:"bytes,2,rep,name=exercises,proto3" json:"exercises,omitempty"`
+	Biometrics []*BiometricRecord `protobuf:"bytes,3,rep,name=biometrics,proto3" json:"biometrics,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Snapshot) GetServings() []*ServingRecord {
+	if x != nil {
+		return x.Servings
+	}
+	return nil
+}
+
+func (x *Snapshot) GetExercises() []*ExerciseRecord {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+func (x *Snapshot) GetBiometrics() []*BiometricRecord {
+	if x != nil {
+		return x.Biometrics
+	}
+	return nil
+}
+
+// DateRange bounds the days of the records returned, inclusively. Unset dates leave the range unbounded on that side.
+type DateRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *Date `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *Date `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DateRange) GetFrom() *Date {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRange) GetTo() *Date {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListServingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range *DateRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *ListServingsRequest) Reset() {
+	*x = ListServingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServingsRequest) ProtoMessage() {}
+
+func (x *ListServingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServingsRequest.ProtoReflect.Descriptor instead.
+func (*ListServingsRequest) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListServingsRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type ListServingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servings []*ServingRecord `protobuf:"bytes,1,rep,name=servings,proto3" json:"servings,omitempty"`
+}
+
+func (x *ListServingsResponse) Reset() {
+	*x = ListServingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServingsResponse) ProtoMessage() {}
+
+func (x *ListServingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServingsResponse.ProtoReflect.Descriptor instead.
+func (*ListServingsResponse) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListServingsResponse) GetServings() []*ServingRecord {
+	if x != nil {
+		return x.Servings
+	}
+	return nil
+}
+
+type ListExercisesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range *DateRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *ListExercisesRequest) Reset() {
+	*x = ListExercisesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExercisesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExercisesRequest) ProtoMessage() {}
+
+func (x *ListExercisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExercisesRequest.ProtoReflect.Descriptor instead.
+func (*ListExercisesRequest) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListExercisesRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type ListExercisesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exercises []*ExerciseRecord `protobuf:"bytes,1,rep,name=exercises,proto3" json:"exercises,omitempty"`
+}
+
+func (x *ListExercisesResponse) Reset() {
+	*x = ListExercisesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExercisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExercisesResponse) ProtoMessage() {}
+
+func (x *ListExercisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExercisesResponse.ProtoReflect.Descriptor instead.
+func (*ListExercisesResponse) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListExercisesResponse) GetExercises() []*ExerciseRecord {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+type ListBiometricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range *DateRange `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// Metric limits the biometrics to a metric, matched without regard to case. All metrics are returned when empty.
+	Metric string `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *ListBiometricsRequest) Reset() {
+	*x = ListBiometricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBiometricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBiometricsRequest) ProtoMessage() {}
+
+func (x *ListBiometricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBiometricsRequest.ProtoReflect.Descriptor instead.
+func (*ListBiometricsRequest) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBiometricsRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *ListBiometricsRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+type ListBiometricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Biometrics []*BiometricRecord `protobuf:"bytes,1,rep,name=biometrics,proto3" json:"biometrics,omitempty"`
+}
+
+func (x *ListBiometricsResponse) Reset() {
+	*x = ListBiometricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gocronometer_v1_records_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBiometricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBiometricsResponse) ProtoMessage() {}
+
+func (x *ListBiometricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gocronometer_v1_records_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBiometricsResponse.ProtoReflect.Descriptor instead.
+func (*ListBiometricsResponse) Descriptor() ([]byte, []int) {
+	return file_gocronometer_v1_records_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBiometricsResponse) GetBiometrics() []*BiometricRecord {
+	if x != nil {
+		return x.Biometrics
+	}
+	return nil
+}
+
+var File_gocronometer_v1_records_proto protoreflect.FileDescriptor
+
+var file_gocronometer_v1_records_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0f, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0xed, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6f, 0x6f, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x6f, 0x64, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x4b, 0x0a, 0x09, 0x6e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x4e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x4e, 0x75, 0x74, 0x72, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x64, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x03, 0x64,
+	0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f,
+	0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x61,
+	0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x22, 0xda, 0x01, 0x0a,
+	0x0f, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61,
+	0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x08, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x62, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x62, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x22, 0x5d, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x22, 0x61, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x63, 0x72,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x22, 0x5a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0a, 0x62, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x62, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x32, 0xc6, 0x04, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f,
+	0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73,
+	0x12, 0x25, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x63, 0x72,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x63,
+	0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x0f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12,
+	0x25, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x10, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x26, 0x2e,
+	0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x30, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x6b, 0x65, 0x2f, 0x67, 0x6f,
+	0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67,
+	0x6f, 0x63, 0x72, 0x6f, 0x6e, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gocronometer_v1_records_proto_rawDescOnce sync.Once
+	file_gocronometer_v1_records_proto_rawDescData = file_gocronometer_v1_records_proto_rawDesc
+)
+
+func file_gocronometer_v1_records_proto_rawDescGZIP() []byte {
+	file_gocronometer_v1_records_proto_rawDescOnce.Do(func() {
+		file_gocronometer_v1_records_proto_rawDescData = protoimpl.X.CompressGZIP(file_gocronometer_v1_records_proto_rawDescData)
+	})
+	return file_gocronometer_v1_records_proto_rawDescData
+}
+
+var file_gocronometer_v1_records_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_gocronometer_v1_records_proto_goTypes = []interface{}{
+	(*Date)(nil),                   // 0: gocronometer.v1.Date
+	(*ServingRecord)(nil),          // 1: gocronometer.v1.ServingRecord
+	(*ExerciseRecord)(nil),         // 2: gocronometer.v1.ExerciseRecord
+	(*BiometricRecord)(nil),        // 3: gocronometer.v1.BiometricRecord
+	(*Snapshot)(nil),               // 4: gocronometer.v1.Snapshot
+	(*DateRange)(nil),              // 5: gocronometer.v1.DateRange
+	(*ListServingsRequest)(nil),    // 6: gocronometer.v1.ListServingsRequest
+	(*ListServingsResponse)(nil),   // 7: gocronometer.v1.ListServingsResponse
+	(*ListExercisesRequest)(nil),   // 8: gocronometer.v1.ListExercisesRequest
+	(*ListExercisesResponse)(nil),  // 9: gocronometer.v1.ListExercisesResponse
+	(*ListBiometricsRequest)(nil),  // 10: gocronometer.v1.ListBiometricsRequest
+	(*ListBiometricsResponse)(nil), // 11: gocronometer.v1.ListBiometricsResponse
+	nil,                            // 12: gocronometer.v1.ServingRecord.NutrientsEntry
+	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
+}
+var file_gocronometer_v1_records_proto_depIdxs = []int32{
+	13, // 0: gocronometer.v1.ServingRecord.recorded_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: gocronometer.v1.ServingRecord.day:type_name -> gocronometer.v1.Date
+	12, // 2: gocronometer.v1.ServingRecord.nutrients:type_name -> gocronometer.v1.ServingRecord.NutrientsEntry
+	13, // 3: gocronometer.v1.ExerciseRecord.recorded_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: gocronometer.v1.ExerciseRecord.day:type_name -> gocronometer.v1.Date
+	13, // 5: gocronometer.v1.BiometricRecord.recorded_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: gocronometer.v1.BiometricRecord.day:type_name -> gocronometer.v1.Date
+	1,  // 7: gocronometer.v1.Snapshot.servings:type_name -> gocronometer.v1.ServingRecord
+	2,  // 8: gocronometer.v1.Snapshot.exercises:type_name -> gocronometer.v1.ExerciseRecord
+	3,  // 9: gocronometer.v1.Snapshot.biometrics:type_name -> gocronometer.v1.BiometricRecord
+	0,  // 10: gocronometer.v1.DateRange.from:type_name -> gocronometer.v1.Date
+	0,  // 11: gocronometer.v1.DateRange.to:type_name -> gocronometer.v1.Date
+	5,  // 12: gocronometer.v1.ListServingsRequest.range:type_name -> gocronometer.v1.DateRange
+	1,  // 13: gocronometer.v1.ListServingsResponse.servings:type_name -> gocronometer.v1.ServingRecord
+	5,  // 14: gocronometer.v1.ListExercisesRequest.range:type_name -> gocronometer.v1.DateRange
+	2,  // 15: gocronometer.v1.ListExercisesResponse.exercises:type_name -> gocronometer.v1.ExerciseRecord
+	5,  // 16: gocronometer.v1.ListBiometricsRequest.range:type_name -> gocronometer.v1.DateRange
+	3,  // 17: gocronometer.v1.ListBiometricsResponse.biometrics:type_name -> gocronometer.v1.BiometricRecord
+	6,  // 18: gocronometer.v1.RecordService.ListServings:input_type -> gocronometer.v1.ListServingsRequest
+	8,  // 19: gocronometer.v1.RecordService.ListExercises:input_type -> gocronometer.v1.ListExercisesRequest
+	10, // 20: gocronometer.v1.RecordService.ListBiometrics:input_type -> gocronometer.v1.ListBiometricsRequest
+	6,  // 21: gocronometer.v1.RecordService.StreamServings:input_type -> gocronometer.v1.ListServingsRequest
+	8,  // 22: gocronometer.v1.RecordService.StreamExercises:input_type -> gocronometer.v1.ListExercisesRequest
+	10, // 23: gocronometer.v1.RecordService.StreamBiometrics:input_type -> gocronometer.v1.ListBiometricsRequest
+	7,  // 24: gocronometer.v1.RecordService.ListServings:output_type -> gocronometer.v1.ListServingsResponse
+	9,  // 25: gocronometer.v1.RecordService.ListExercises:output_type -> gocronometer.v1.ListExercisesResponse
+	11, // 26: gocronometer.v1.RecordService.ListBiometrics:output_type -> gocronometer.v1.ListBiometricsResponse
+	1,  // 27: gocronometer.v1.RecordService.StreamServings:output_type -> gocronometer.v1.ServingRecord
+	2,  // 28: gocronometer.v1.RecordService.StreamExercises:output_type -> gocronometer.v1.ExerciseRecord
+	3,  // 29: gocronometer.v1.RecordService.StreamBiometrics:output_type -> gocronometer.v1.BiometricRecord
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_gocronometer_v1_records_proto_init() }
+func file_gocronometer_v1_records_proto_init() {
+	if File_gocronometer_v1_records_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gocronometer_v1_records_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExerciseRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BiometricRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExercisesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExercisesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBiometricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gocronometer_v1_records_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBiometricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gocronometer_v1_records_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gocronometer_v1_records_proto_goTypes,
+		DependencyIndexes: file_gocronometer_v1_records_proto_depIdxs,
+		MessageInfos:      file_gocronometer_v1_records_proto_msgTypes,
+	}.Build()
+	File_gocronometer_v1_records_proto = out.File
+	file_gocronometer_v1_records_proto_rawDesc = nil
+	file_gocronometer_v1_records_proto_goTypes = nil
+	file_gocronometer_v1_records_proto_depIdxs = nil
+}
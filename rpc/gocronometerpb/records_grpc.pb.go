@@ -0,0 +1,379 @@
+// Protocol buffer definitions of the parsed Cronometer records and the gRPC service serving them. The Go code in
+// rpc/gocronometerpb is generated from this file with protoc-gen-go and protoc-gen-go-grpc.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: gocronometer/v1/records.proto
+
+package gocronometerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RecordService_ListServings_FullMethodName     = "/gocronometer.v1.RecordService/ListServings"
+	RecordService_ListExercises_FullMethodName    = "/gocronometer.v1.RecordService/ListExercises"
+	RecordService_ListBiometrics_FullMethodName   = "/gocronometer.v1.RecordService/ListBiometrics"
+	RecordService_StreamServings_FullMethodName   = "/gocronometer.v1.RecordService/StreamServings"
+	RecordService_StreamExercises_FullMethodName  = "/gocronometer.v1.RecordService/StreamExercises"
+	RecordService_StreamBiometrics_FullMethodName = "/gocronometer.v1.RecordService/StreamBiometrics"
+)
+
+// RecordServiceClient is the client API for RecordService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecordServiceClient interface {
+	ListServings(ctx context.Context, in *ListServingsRequest, opts ...grpc.CallOption) (*ListServingsResponse, error)
+	ListExercises(ctx context.Context, in *ListExercisesRequest, opts ...grpc.CallOption) (*ListExercisesResponse, error)
+	ListBiometrics(ctx context.Context, in *ListBiometricsRequest, opts ...grpc.CallOption) (*ListBiometricsResponse, error)
+	StreamServings(ctx context.Context, in *ListServingsRequest, opts ...grpc.CallOption) (RecordService_StreamServingsClient, error)
+	StreamExercises(ctx context.Context, in *ListExercisesRequest, opts ...grpc.CallOption) (RecordService_StreamExercisesClient, error)
+	StreamBiometrics(ctx context.Context, in *ListBiometricsRequest, opts ...grpc.CallOption) (RecordService_StreamBiometricsClient, error)
+}
+
+type recordServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecordServiceClient(cc grpc.ClientConnInterface) RecordServiceClient {
+	return &recordServiceClient{cc}
+}
+
+func (c *recordServiceClient) ListServings(ctx context.Context, in *ListServingsRequest, opts ...grpc.CallOption) (*ListServingsResponse, error) {
+	out := new(ListServingsResponse)
+	err := c.cc.Invoke(ctx, RecordService_ListServings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recordServiceClient) ListExercises(ctx context.Context, in *ListExercisesRequest, opts ...grpc.CallOption) (*ListExercisesResponse, error) {
+	out := new(ListExercisesResponse)
+	err := c.cc.Invoke(ctx, RecordService_ListExercises_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recordServiceClient) ListBiometrics(ctx context.Context, in *ListBiometricsRequest, opts ...grpc.CallOption) (*ListBiometricsResponse, error) {
+	out := new(ListBiometricsResponse)
+	err := c.cc.Invoke(ctx, RecordService_ListBiometrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recordServiceClient) StreamServings(ctx context.Context, in *ListServingsRequest, opts ...grpc.CallOption) (RecordService_StreamServingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RecordService_ServiceDesc.Streams[0], RecordService_StreamServings_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &recordServiceStreamServingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RecordService_StreamServingsClient interface {
+	Recv() (*ServingRecord, error)
+	grpc.ClientStream
+}
+
+type recordServiceStreamServingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *recordServiceStreamServingsClient) Recv() (*ServingRecord, error) {
+	m := new(ServingRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *recordServiceClient) StreamExercises(ctx context.Context, in *ListExercisesRequest, opts ...grpc.CallOption) (RecordService_StreamExercisesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RecordService_ServiceDesc.Streams[1], RecordService_StreamExercises_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &recordServiceStreamExercisesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RecordService_StreamExercisesClient interface {
+	Recv() (*ExerciseRecord, error)
+	grpc.ClientStream
+}
+
+type recordServiceStreamExercisesClient struct {
+	grpc.ClientStream
+}
+
+func (x *recordServiceStreamExercisesClient) Recv() (*ExerciseRecord, error) {
+	m := new(ExerciseRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *recordServiceClient) StreamBiometrics(ctx context.Context, in *ListBiometricsRequest, opts ...grpc.CallOption) (RecordService_StreamBiometricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RecordService_ServiceDesc.Streams[2], RecordService_StreamBiometrics_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &recordServiceStreamBiometricsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RecordService_StreamBiometricsClient interface {
+	Recv() (*BiometricRecord, error)
+	grpc.ClientStream
+}
+
+type recordServiceStreamBiometricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *recordServiceStreamBiometricsClient) Recv() (*BiometricRecord, error) {
+	m := new(BiometricRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RecordServiceServer is the server API for RecordService service.
+// All implementations must embed UnimplementedRecordServiceServer
+// for forward compatibility
+type RecordServiceServer interface {
+	ListServings(context.Context, *ListServingsRequest) (*ListServingsResponse, error)
+	ListExercises(context.Context, *ListExercisesRequest) (*ListExercisesResponse, error)
+	ListBiometrics(context.Context, *ListBiometricsRequest) (*ListBiometricsResponse, error)
+	StreamServings(*ListServingsRequest, RecordService_StreamServingsServer) error
+	StreamExercises(*ListExercisesRequest, RecordService_StreamExercisesServer) error
+	StreamBiometrics(*ListBiometricsRequest, RecordService_StreamBiometricsServer) error
+	mustEmbedUnimplementedRecordServiceServer()
+}
+
+// UnimplementedRecordServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRecordServiceServer struct {
+}
+
+func (UnimplementedRecordServiceServer) ListServings(context.Context, *ListServingsRequest) (*ListServingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServings not implemented")
+}
+func (UnimplementedRecordServiceServer) ListExercises(context.Context, *ListExercisesRequest) (*ListExercisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExercises not implemented")
+}
+func (UnimplementedRecordServiceServer) ListBiometrics(context.Context, *ListBiometricsRequest) (*ListBiometricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBiometrics not implemented")
+}
+func (UnimplementedRecordServiceServer) StreamServings(*ListServingsRequest, RecordService_StreamServingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamServings not implemented")
+}
+func (UnimplementedRecordServiceServer) StreamExercises(*ListExercisesRequest, RecordService_StreamExercisesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExercises not implemented")
+}
+func (UnimplementedRecordServiceServer) StreamBiometrics(*ListBiometricsRequest, RecordService_StreamBiometricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBiometrics not implemented")
+}
+func (UnimplementedRecordServiceServer) mustEmbedUnimplementedRecordServiceServer() {}
+
+// UnsafeRecordServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecordServiceServer will
+// result in compilation errors.
+type UnsafeRecordServiceServer interface {
+	mustEmbedUnimplementedRecordServiceServer()
+}
+
+func RegisterRecordServiceServer(s grpc.ServiceRegistrar, srv RecordServiceServer) {
+	s.RegisterService(&RecordService_ServiceDesc, srv)
+}
+
+func _RecordService_ListServings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecordServiceServer).ListServings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecordService_ListServings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecordServiceServer).ListServings(ctx, req.(*ListServingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecordService_ListExercises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExercisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecordServiceServer).ListExercises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecordService_ListExercises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecordServiceServer).ListExercises(ctx, req.(*ListExercisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecordService_ListBiometrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBiometricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecordServiceServer).ListBiometrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecordService_ListBiometrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecordServiceServer).ListBiometrics(ctx, req.(*ListBiometricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecordService_StreamServings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListServingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecordServiceServer).StreamServings(m, &recordServiceStreamServingsServer{stream})
+}
+
+type RecordService_StreamServingsServer interface {
+	Send(*ServingRecord) error
+	grpc.ServerStream
+}
+
+type recordServiceStreamServingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *recordServiceStreamServingsServer) Send(m *ServingRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RecordService_StreamExercises_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListExercisesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecordServiceServer).StreamExercises(m, &recordServiceStreamExercisesServer{stream})
+}
+
+type RecordService_StreamExercisesServer interface {
+	Send(*ExerciseRecord) error
+	grpc.ServerStream
+}
+
+type recordServiceStreamExercisesServer struct {
+	grpc.ServerStream
+}
+
+func (x *recordServiceStreamExercisesServer) Send(m *ExerciseRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RecordService_StreamBiometrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBiometricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecordServiceServer).StreamBiometrics(m, &recordServiceStreamBiometricsServer{stream})
+}
+
+type RecordService_StreamBiometricsServer interface {
+	Send(*BiometricRecord) error
+	grpc.ServerStream
+}
+
+type recordServiceStreamBiometricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *recordServiceStreamBiometricsServer) Send(m *BiometricRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RecordService_ServiceDesc is the grpc.ServiceDesc for RecordService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecordService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gocronometer.v1.RecordService",
+	HandlerType: (*RecordServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListServings",
+			Handler:    _RecordService_ListServings_Handler,
+		},
+		{
+			MethodName: "ListExercises",
+			Handler:    _RecordService_ListExercises_Handler,
+		},
+		{
+			MethodName: "ListBiometrics",
+			Handler:    _RecordService_ListBiometrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamServings",
+			Handler:       _RecordService_StreamServings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamExercises",
+			Handler:       _RecordService_StreamExercises_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBiometrics",
+			Handler:       _RecordService_StreamBiometrics_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gocronometer/v1/records.proto",
+}
@@ -0,0 +1,283 @@
+// Package rpc serves parsed Cronometer records over gRPC. The protocol buffer definitions are in
+// proto/gocronometer/v1/records.proto and the generated messages and clients are in the gocronometerpb package.
+//
+// The records are provided by a serve.Source, so the same records can be served over both HTTP and gRPC:
+//
+//	server := grpc.NewServer()
+//	gocronometerpb.RegisterRecordServiceServer(server, rpc.NewServer(source))
+//
+// The records can also be stored as binary snapshots without the service with MarshalExport and UnmarshalExport.
+package rpc
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/rpc/gocronometerpb"
+	"github.com/burke/gocronometer/serve"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// Server implements the RecordService of the gocronometerpb package.
+type Server struct {
+	gocronometerpb.UnimplementedRecordServiceServer
+
+	source serve.Source
+}
+
+// NewServer creates a Server for the source.
+func NewServer(source serve.Source) *Server {
+	return &Server{source: source}
+}
+
+// ListServings returns the servings in the range.
+func (s *Server) ListServings(ctx context.Context, req *gocronometerpb.ListServingsRequest) (*gocronometerpb.ListServingsResponse, error) {
+	records, err := s.servings(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gocronometerpb.ListServingsResponse{Servings: make([]*gocronometerpb.ServingRecord, 0, len(records))}
+	for _, r := range records {
+		resp.Servings = append(resp.Servings, FromServing(r))
+	}
+	return resp, nil
+}
+
+// ListExercises returns the exercises in the range.
+func (s *Server) ListExercises(ctx context.Context, req *gocronometerpb.ListExercisesRequest) (*gocronometerpb.ListExercisesResponse, error) {
+	records, err := s.exercises(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gocronometerpb.ListExercisesResponse{Exercises: make([]*gocronometerpb.ExerciseRecord, 0, len(records))}
+	for _, r := range records {
+		resp.Exercises = append(resp.Exercises, FromExercise(r))
+	}
+	return resp, nil
+}
+
+// ListBiometrics returns the biometrics in the range.
+func (s *Server) ListBiometrics(ctx context.Context, req *gocronometerpb.ListBiometricsRequest) (*gocronometerpb.ListBiometricsResponse, error) {
+	records, err := s.biometrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gocronometerpb.ListBiometricsResponse{Biometrics: make([]*gocronometerpb.BiometricRecord, 0, len(records))}
+	for _, r := range records {
+		resp.Biometrics = append(resp.Biometrics, FromBiometric(r))
+	}
+	return resp, nil
+}
+
+// StreamServings sends the servings in the range one at a time.
+func (s *Server) StreamServings(req *gocronometerpb.ListServingsRequest, stream gocronometerpb.RecordService_StreamServingsServer) error {
+	records, err := s.servings(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if err := stream.Send(FromServing(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamExercises sends the exercises in the range one at a time.
+func (s *Server) StreamExercises(req *gocronometerpb.ListExercisesRequest, stream gocronometerpb.RecordService_StreamExercisesServer) error {
+	records, err := s.exercises(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if err := stream.Send(FromExercise(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamBiometrics sends the biometrics in the range one at a time.
+func (s *Server) StreamBiometrics(req *gocronometerpb.ListBiometricsRequest, stream gocronometerpb.RecordService_StreamBiometricsServer) error {
+	records, err := s.biometrics(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if err := stream.Send(FromBiometric(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// servings queries the source for the servings of the request, sorted by time.
+func (s *Server) servings(ctx context.Context, req *gocronometerpb.ListServingsRequest) (gocronometer.ServingRecords, error) {
+	from, to := dateRange(req.GetRange())
+	records, err := s.source.Servings(ctx, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "querying servings: %s", err)
+	}
+
+	records.SortByTime()
+	return records, nil
+}
+
+// exercises queries the source for the exercises of the request, sorted by time.
+func (s *Server) exercises(ctx context.Context, req *gocronometerpb.ListExercisesRequest) (gocronometer.ExerciseRecords, error) {
+	from, to := dateRange(req.GetRange())
+	records, err := s.source.Exercises(ctx, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "querying exercises: %s", err)
+	}
+
+	records.SortByTime()
+	return records, nil
+}
+
+// biometrics queries the source for the biometrics of the request, sorted by time.
+func (s *Server) biometrics(ctx context.Context, req *gocronometerpb.ListBiometricsRequest) (gocronometer.BiometricRecords, error) {
+	from, to := dateRange(req.GetRange())
+	records, err := s.source.Biometrics(ctx, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "querying biometrics: %s", err)
+	}
+
+	if metric := req.GetMetric(); metric != "" {
+		var filtered gocronometer.BiometricRecords
+		for _, b := range records {
+			if strings.EqualFold(b.Metric, metric) {
+				filtered = append(filtered, b)
+			}
+		}
+		records = filtered
+	}
+
+	records.SortByTime()
+	return records, nil
+}
+
+// dateRange converts the range, leaving unset dates as zero dates.
+func dateRange(r *gocronometerpb.DateRange) (from gocronometer.Date, to gocronometer.Date) {
+	return ToDate(r.GetFrom()), ToDate(r.GetTo())
+}
+
+// FromDate converts the date to its message. The zero date is converted to nil.
+func FromDate(d gocronometer.Date) *gocronometerpb.Date {
+	if d.IsZero() {
+		return nil
+	}
+	return &gocronometerpb.Date{Year: int32(d.Year), Month: int32(d.Month), Day: int32(d.Day)}
+}
+
+// ToDate converts the message to a date. Nil is converted to the zero date.
+func ToDate(d *gocronometerpb.Date) gocronometer.Date {
+	if d == nil {
+		return gocronometer.Date{}
+	}
+	return gocronometer.Date{Year: int(d.Year), Month: time.Month(d.Month), Day: int(d.Day)}
+}
+
+// FromServing converts the serving to its message.
+func FromServing(s gocronometer.ServingRecord) *gocronometerpb.ServingRecord {
+	msg := &gocronometerpb.ServingRecord{
+		RecordedTime:  timestamppb.New(s.RecordedTime),
+		Day:           FromDate(s.Day),
+		HasTime:       s.HasTime,
+		Group:         s.Group,
+		FoodName:      s.FoodName,
+		Source:        s.Source,
+		FoodId:        s.FoodID,
+		QuantityValue: s.QuantityValue,
+		QuantityUnits: s.QuantityUnits,
+		Category:      s.Category,
+		Nutrients:     map[string]float64{},
+	}
+
+	for _, n := range gocronometer.Nutrients() {
+		if v := n.Value(s); v != 0 {
+			msg.Nutrients[n.Name] = v
+		}
+	}
+	return msg
+}
+
+// ToServing converts the message to a serving. Nutrients with unknown names are ignored.
+func ToServing(msg *gocronometerpb.ServingRecord) gocronometer.ServingRecord {
+	s := gocronometer.ServingRecord{
+		RecordedTime:  msg.GetRecordedTime().AsTime(),
+		Day:           ToDate(msg.GetDay()),
+		HasTime:       msg.GetHasTime(),
+		Group:         msg.GetGroup(),
+		FoodName:      msg.GetFoodName(),
+		Source:        msg.GetSource(),
+		FoodID:        msg.GetFoodId(),
+		QuantityValue: msg.GetQuantityValue(),
+		QuantityUnits: msg.GetQuantityUnits(),
+		Category:      msg.GetCategory(),
+	}
+
+	for name, v := range msg.GetNutrients() {
+		if n, ok := gocronometer.LookupNutrient(name); ok {
+			n.Set(&s, v)
+		}
+	}
+	return s
+}
+
+// FromExercise converts the exercise to its message.
+func FromExercise(e gocronometer.ExerciseRecord) *gocronometerpb.ExerciseRecord {
+	return &gocronometerpb.ExerciseRecord{
+		RecordedTime:   timestamppb.New(e.RecordedTime),
+		Day:            FromDate(e.Day),
+		HasTime:        e.HasTime,
+		Exercise:       e.Exercise,
+		Minutes:        e.Minutes,
+		CaloriesBurned: e.CaloriesBurned,
+	}
+}
+
+// ToExercise converts the message to an exercise.
+func ToExercise(msg *gocronometerpb.ExerciseRecord) gocronometer.ExerciseRecord {
+	return gocronometer.ExerciseRecord{
+		RecordedTime:   msg.GetRecordedTime().AsTime(),
+		Day:            ToDate(msg.GetDay()),
+		HasTime:        msg.GetHasTime(),
+		Exercise:       msg.GetExercise(),
+		Minutes:        msg.GetMinutes(),
+		CaloriesBurned: msg.GetCaloriesBurned(),
+	}
+}
+
+// FromBiometric converts the biometric to its message.
+func FromBiometric(b gocronometer.BiometricRecord) *gocronometerpb.BiometricRecord {
+	return &gocronometerpb.BiometricRecord{
+		RecordedTime: timestamppb.New(b.RecordedTime),
+		Day:          FromDate(b.Day),
+		HasTime:      b.HasTime,
+		Metric:       b.Metric,
+		Unit:         b.Unit,
+		Amount:       b.Amount,
+	}
+}
+
+// ToBiometric converts the message to a biometric.
+func ToBiometric(msg *gocronometerpb.BiometricRecord) gocronometer.BiometricRecord {
+	return gocronometer.BiometricRecord{
+		RecordedTime: msg.GetRecordedTime().AsTime(),
+		Day:          ToDate(msg.GetDay()),
+		HasTime:      msg.GetHasTime(),
+		Metric:       msg.GetMetric(),
+		Unit:         msg.GetUnit(),
+		Amount:       msg.GetAmount(),
+	}
+}
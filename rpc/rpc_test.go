@@ -0,0 +1,97 @@
+package rpc_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/rpc"
+	"github.com/burke/gocronometer/rpc/gocronometerpb"
+	"github.com/burke/gocronometer/serve"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func date(day int) gocronometer.Date {
+	return gocronometer.Date{Year: 2021, Month: time.June, Day: day}
+}
+
+func newClient(t *testing.T) gocronometerpb.RecordServiceClient {
+	t.Helper()
+
+	source := serve.NewMemorySource(
+		gocronometer.ServingRecords{
+			{Day: date(2), RecordedTime: time.Date(2021, 6, 2, 8, 0, 0, 0, time.UTC), FoodName: "Eggs", VitaminKUg: 0.5},
+			{Day: date(1), RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), FoodName: "Toast", EnergyKcal: 150},
+		},
+		nil,
+		gocronometer.BiometricRecords{
+			{Day: date(1), Metric: "Weight", Unit: "kg", Amount: 80},
+			{Day: date(1), Metric: "Heart Rate", Unit: "bpm", Amount: 60},
+		},
+	)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	gocronometerpb.RegisterRecordServiceServer(server, rpc.NewServer(source))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return gocronometerpb.NewRecordServiceClient(conn)
+}
+
+func TestServer_ListServings(t *testing.T) {
+	client := newClient(t)
+
+	resp, err := client.ListServings(context.Background(), &gocronometerpb.ListServingsRequest{
+		Range: &gocronometerpb.DateRange{From: rpc.FromDate(date(2))},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Servings) != 1 {
+		t.Fatalf("expected 1 serving but found %d", len(resp.Servings))
+	}
+
+	serving := rpc.ToServing(resp.Servings[0])
+	if serving.FoodName != "Eggs" || serving.Day != date(2) || serving.VitaminKUg != 0.5 || serving.VitaminKMg != 0.5 {
+		t.Fatalf("unexpected serving: %+v", serving)
+	}
+}
+
+func TestServer_StreamBiometrics(t *testing.T) {
+	client := newClient(t)
+
+	stream, err := client.StreamBiometrics(context.Background(), &gocronometerpb.ListBiometricsRequest{Metric: "weight"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records gocronometer.BiometricRecords
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rpc.ToBiometric(msg))
+	}
+
+	if len(records) != 1 || records[0].Amount != 80 {
+		t.Fatalf("unexpected biometrics: %+v", records)
+	}
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"fmt"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/rpc/gocronometerpb"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// MarshalExport encodes the servings, exercises and biometrics of the export as a Snapshot message, a compact binary
+// form that can be stored and read from other languages with the definitions in records.proto. The notes, daily
+// nutrition and kind of the export are not encoded.
+func MarshalExport(e gocronometer.Export) ([]byte, error) {
+	snapshot := &gocronometerpb.Snapshot{}
+	for _, s := range e.Servings {
+		snapshot.Servings = append(snapshot.Servings, FromServing(s))
+	}
+	for _, x := range e.Exercises {
+		snapshot.Exercises = append(snapshot.Exercises, FromExercise(x))
+	}
+	for _, b := range e.Biometrics {
+		snapshot.Biometrics = append(snapshot.Biometrics, FromBiometric(b))
+	}
+
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalExport decodes a Snapshot message encoded by MarshalExport. The recorded times are set to location, as the
+// messages only hold the instant of each record. A nil location is treated as UTC.
+func UnmarshalExport(data []byte, location *time.Location) (gocronometer.Export, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	snapshot := &gocronometerpb.Snapshot{}
+	if err := proto.Unmarshal(data, snapshot); err != nil {
+		return gocronometer.Export{}, fmt.Errorf("unmarshaling snapshot: %w", err)
+	}
+
+	var e gocronometer.Export
+	for _, msg := range snapshot.GetServings() {
+		s := ToServing(msg)
+		s.RecordedTime = s.RecordedTime.In(location)
+		e.Servings = append(e.Servings, s)
+	}
+	for _, msg := range snapshot.GetExercises() {
+		x := ToExercise(msg)
+		x.RecordedTime = x.RecordedTime.In(location)
+		e.Exercises = append(e.Exercises, x)
+	}
+	for _, msg := range snapshot.GetBiometrics() {
+		b := ToBiometric(msg)
+		b.RecordedTime = b.RecordedTime.In(location)
+		e.Biometrics = append(e.Biometrics, b)
+	}
+	return e, nil
+}
+
+// MarshalServings encodes the servings as a Snapshot message holding only servings.
+func MarshalServings(servings gocronometer.ServingRecords) ([]byte, error) {
+	return MarshalExport(gocronometer.Export{Servings: servings})
+}
+
+// UnmarshalServings decodes the servings of a Snapshot message. Other records in the snapshot are ignored.
+func UnmarshalServings(data []byte, location *time.Location) (gocronometer.ServingRecords, error) {
+	e, err := UnmarshalExport(data, location)
+	return e.Servings, err
+}
+
+// MarshalExercises encodes the exercises as a Snapshot message holding only exercises.
+func MarshalExercises(exercises gocronometer.ExerciseRecords) ([]byte, error) {
+	return MarshalExport(gocronometer.Export{Exercises: exercises})
+}
+
+// UnmarshalExercises decodes the exercises of a Snapshot message. Other records in the snapshot are ignored.
+func UnmarshalExercises(data []byte, location *time.Location) (gocronometer.ExerciseRecords, error) {
+	e, err := UnmarshalExport(data, location)
+	return e.Exercises, err
+}
+
+// MarshalBiometrics encodes the biometrics as a Snapshot message holding only biometrics.
+func MarshalBiometrics(biometrics gocronometer.BiometricRecords) ([]byte, error) {
+	return MarshalExport(gocronometer.Export{Biometrics: biometrics})
+}
+
+// UnmarshalBiometrics decodes the biometrics of a Snapshot message. Other records in the snapshot are ignored.
+func UnmarshalBiometrics(data []byte, location *time.Location) (gocronometer.BiometricRecords, error) {
+	e, err := UnmarshalExport(data, location)
+	return e.Biometrics, err
+}
@@ -0,0 +1,79 @@
+package rpc_test
+
+import (
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/rpc"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalExport(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	servings, err := gocronometer.ParseServings(strings.NewReader("Day,Time,Group,Food Name,Amount,Energy (kcal),Vitamin K (µg)\n"+
+		"2021-06-01,08:00,Breakfast,Eggs,2 large,140,0.3\n"), gocronometer.WithLocation(location))
+	if err != nil {
+		t.Fatal(err)
+	}
+	export := gocronometer.Export{
+		Servings:   servings,
+		Exercises:  gocronometer.ExerciseRecords{{RecordedTime: date(1).Time(location), Day: date(1), Exercise: "Walking", Minutes: 30}},
+		Biometrics: gocronometer.BiometricRecords{{RecordedTime: date(2).Time(location), Day: date(2), Metric: "Weight", Unit: "kg", Amount: 80}},
+	}
+
+	data, err := rpc.MarshalExport(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := rpc.UnmarshalExport(data, location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !decoded.Servings.Equal(export.Servings) || !decoded.Exercises.Equal(export.Exercises) ||
+		!decoded.Biometrics.Equal(export.Biometrics) {
+		t.Fatalf("expected %+v but found %+v", export, decoded)
+	}
+	if decoded.Servings[0].Key() != servings[0].Key() {
+		t.Fatal("expected the key of the serving to survive the round trip")
+	}
+}
+
+func TestMarshalBiometrics(t *testing.T) {
+	biometrics := gocronometer.BiometricRecords{{RecordedTime: date(1).Time(time.UTC), Day: date(1), Metric: "Weight", Amount: 80}}
+
+	data, err := rpc.MarshalBiometrics(biometrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := rpc.UnmarshalBiometrics(data, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(biometrics) {
+		t.Fatalf("expected %+v but found %+v", biometrics, decoded)
+	}
+
+	servings, err := rpc.UnmarshalServings(data, time.UTC)
+	if err != nil || len(servings) != 0 {
+		t.Fatalf("expected no servings but found %+v, %v", servings, err)
+	}
+
+	// A nil location is treated as UTC.
+	decoded, err = rpc.UnmarshalBiometrics(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(biometrics) || decoded[0].RecordedTime.Location() != time.UTC {
+		t.Fatalf("expected %+v in UTC but found %+v", biometrics, decoded)
+	}
+
+	if _, err := rpc.UnmarshalBiometrics([]byte{0xff}, time.UTC); err == nil {
+		t.Fatal("expected an error for an invalid snapshot")
+	}
+}
@@ -0,0 +1,126 @@
+package gocronometer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// The following are the columns understood by the parsers, used to report drift in the export headers.
+var (
+	// servingsColumns are the columns of the servings export, with the nutrient columns taken from nutrients so they
+	// cannot fall out of sync with the columns parsed.
+	servingsColumns = func() []string {
+		columns := []string{"Day", "Time", "Group", "Food Name", "Amount"}
+		for _, n := range nutrients {
+			columns = append(columns, n.Header)
+		}
+		return append(columns, "Category")
+	}()
+
+	// optionalServingsColumns are understood by the parser but are only found in some exports, so they are never
+	// reported as missing.
+	optionalServingsColumns = []string{"Source", "Food ID"}
+
+	exerciseColumns = []string{"Day", "Time", "Exercise", "Minutes", "Calories Burned"}
+
+	biometricColumns = []string{"Day", "Time", "Metric", "Unit", "Amount"}
+
+	noteColumns = []string{"Day", "Time", "Group", "Note"}
+)
+
+// SchemaReport describes the differences between the columns of an export and the columns understood by the parser.
+// Cronometer periodically changes the export headers and the parsers ignore columns they do not recognize, so checking
+// the report allows consumers to detect changes before data is silently dropped.
+type SchemaReport struct {
+	// Missing contains the columns the parser understands that were not found in the export.
+	Missing []string
+
+	// Unrecognized contains the columns found in the export that the parser does not understand.
+	Unrecognized []string
+}
+
+// OK returns true when the export columns exactly match the columns understood by the parser.
+func (r SchemaReport) OK() bool {
+	return len(r.Missing) == 0 && len(r.Unrecognized) == 0
+}
+
+// String summarizes the report for logging.
+func (r SchemaReport) String() string {
+	if r.OK() {
+		return "schema ok"
+	}
+	return fmt.Sprintf("missing columns %q, unrecognized columns %q", r.Missing, r.Unrecognized)
+}
+
+// ValidateServingsHeaders compares the headers of a servings export with the columns understood by
+// ParseServingsExport.
+func ValidateServingsHeaders(headers []string) SchemaReport {
+	return validateHeaders(headers, servingsColumns, optionalServingsColumns)
+}
+
+// ValidateExerciseHeaders compares the headers of an exercises export with the columns understood by
+// ParseExerciseExport.
+func ValidateExerciseHeaders(headers []string) SchemaReport {
+	return validateHeaders(headers, exerciseColumns, nil)
+}
+
+// ValidateBiometricHeaders compares the headers of a biometrics export with the columns understood by
+// ParseBiometricRecordsExport.
+func ValidateBiometricHeaders(headers []string) SchemaReport {
+	return validateHeaders(headers, biometricColumns, nil)
+}
+
+// ValidateNoteHeaders compares the headers of a notes export with the columns understood by ParseNotes.
+func ValidateNoteHeaders(headers []string) SchemaReport {
+	return validateHeaders(headers, noteColumns, nil)
+}
+
+// ReadHeaders reads the header row from the raw CSV export. Only the first row is consumed from rawCSVReader.
+func ReadHeaders(rawCSVReader io.Reader) ([]string, error) {
+	rawCSVReader, err := decompress(rawCSVReader)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(rawCSVReader)
+	headers, err := r.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("export is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading headers: %w", err)
+	}
+
+	return headers, nil
+}
+
+// validateHeaders builds the report of headers against the known columns. Optional columns are understood but never
+// reported as missing. The order of each list in the report follows the order of the columns in known and headers
+// respectively.
+func validateHeaders(headers []string, known []string, optional []string) SchemaReport {
+	found := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		found[h] = true
+	}
+
+	understood := make(map[string]bool, len(known)+len(optional))
+	report := SchemaReport{}
+	for _, k := range known {
+		understood[k] = true
+		if !found[k] {
+			report.Missing = append(report.Missing, k)
+		}
+	}
+	for _, o := range optional {
+		understood[o] = true
+	}
+
+	for _, h := range headers {
+		if !understood[h] {
+			report.Unrecognized = append(report.Unrecognized, h)
+		}
+	}
+
+	return report
+}
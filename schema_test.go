@@ -0,0 +1,34 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"strings"
+	"testing"
+)
+
+func TestValidateExerciseHeaders(t *testing.T) {
+	headers, err := gocronometer.ReadHeaders(strings.NewReader("Day,Exercise,Minutes,Calories Burned,Group\n2021-06-01,Walking,30,120,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := gocronometer.ValidateExerciseHeaders(headers)
+	if report.OK() {
+		t.Fatalf("expected the report to show drift")
+	}
+
+	if len(report.Missing) != 1 || report.Missing[0] != "Time" {
+		t.Fatalf("expected Time to be missing but found %q", report.Missing)
+	}
+
+	if len(report.Unrecognized) != 1 || report.Unrecognized[0] != "Group" {
+		t.Fatalf("expected Group to be unrecognized but found %q", report.Unrecognized)
+	}
+}
+
+func TestValidateBiometricHeaders_OK(t *testing.T) {
+	report := gocronometer.ValidateBiometricHeaders([]string{"Day", "Time", "Metric", "Unit", "Amount"})
+	if !report.OK() {
+		t.Fatalf("expected no drift but found %s", report)
+	}
+}
@@ -0,0 +1,238 @@
+// Package serve exposes parsed Cronometer records over HTTP as JSON for building dashboards and mobile clients.
+//
+// The following endpoints are served. The from and to parameters are optional dates in the format
+// gocronometer.DateFormat, and bound the days returned inclusively.
+//
+//	GET /servings?from=&to=
+//	GET /exercises?from=&to=
+//	GET /biometrics?from=&to=
+//	GET /biometrics/{metric}?from=&to=
+//	GET /daily-totals?from=&to=
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Source provides the records served. Zero dates leave the range unbounded on that side.
+type Source interface {
+	Servings(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ServingRecords, error)
+	Exercises(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ExerciseRecords, error)
+	Biometrics(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.BiometricRecords, error)
+}
+
+// MemorySource is a Source holding the records in memory.
+type MemorySource struct {
+	servings   gocronometer.ServingRecords
+	exercises  gocronometer.ExerciseRecords
+	biometrics gocronometer.BiometricRecords
+}
+
+// NewMemorySource creates a Source serving the records provided.
+func NewMemorySource(servings gocronometer.ServingRecords, exercises gocronometer.ExerciseRecords, biometrics gocronometer.BiometricRecords) *MemorySource {
+	return &MemorySource{servings: servings, exercises: exercises, biometrics: biometrics}
+}
+
+// inRange returns true if the day is within the range, treating zero dates as unbounded.
+func inRange(day gocronometer.Date, from gocronometer.Date, to gocronometer.Date) bool {
+	return (from.IsZero() || !day.Before(from)) && (to.IsZero() || !day.After(to))
+}
+
+// Servings returns the servings in the range.
+func (m *MemorySource) Servings(_ context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ServingRecords, error) {
+	records := gocronometer.ServingRecords{}
+	for _, s := range m.servings {
+		if inRange(s.Day, from, to) {
+			records = append(records, s)
+		}
+	}
+	return records, nil
+}
+
+// Exercises returns the exercises in the range.
+func (m *MemorySource) Exercises(_ context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ExerciseRecords, error) {
+	records := gocronometer.ExerciseRecords{}
+	for _, e := range m.exercises {
+		if inRange(e.Day, from, to) {
+			records = append(records, e)
+		}
+	}
+	return records, nil
+}
+
+// Biometrics returns the biometrics in the range.
+func (m *MemorySource) Biometrics(_ context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.BiometricRecords, error) {
+	records := gocronometer.BiometricRecords{}
+	for _, b := range m.biometrics {
+		if inRange(b.Day, from, to) {
+			records = append(records, b)
+		}
+	}
+	return records, nil
+}
+
+// DailyTotal is the total nutrition of a day.
+type DailyTotal struct {
+	Day   gocronometer.Date
+	Total gocronometer.ServingRecord
+}
+
+// Server is an http.Handler serving the records of a Source.
+type Server struct {
+	source Source
+	mux    *http.ServeMux
+}
+
+// NewServer creates a Server for the source.
+func NewServer(source Source) *Server {
+	s := &Server{source: source, mux: http.NewServeMux()}
+	s.mux.HandleFunc("/servings", s.handleServings)
+	s.mux.HandleFunc("/exercises", s.handleExercises)
+	s.mux.HandleFunc("/biometrics", s.handleBiometrics)
+	s.mux.HandleFunc("/biometrics/", s.handleBiometrics)
+	s.mux.HandleFunc("/daily-totals", s.handleDailyTotals)
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleServings(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	records, err := s.source.Servings(r.Context(), from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	records.SortByTime()
+	writeJSON(w, records)
+}
+
+func (s *Server) handleExercises(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	records, err := s.source.Exercises(r.Context(), from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	records.SortByTime()
+	writeJSON(w, records)
+}
+
+func (s *Server) handleBiometrics(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	metric, err := url.PathUnescape(strings.Trim(strings.TrimPrefix(r.URL.EscapedPath(), "/biometrics"), "/"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid metric: %s", err))
+		return
+	}
+
+	records, err := s.source.Biometrics(r.Context(), from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if metric != "" {
+		filtered := gocronometer.BiometricRecords{}
+		for _, b := range records {
+			if strings.EqualFold(b.Metric, metric) {
+				filtered = append(filtered, b)
+			}
+		}
+		records = filtered
+	}
+	records.SortByTime()
+	writeJSON(w, records)
+}
+
+func (s *Server) handleDailyTotals(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := dateRange(w, r)
+	if !ok {
+		return
+	}
+
+	records, err := s.source.Servings(r.Context(), from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	days := gocronometer.GroupBy(records, func(s gocronometer.ServingRecord) gocronometer.Date {
+		return s.Day
+	})
+	totals := make([]DailyTotal, 0, len(days))
+	for day, servings := range days {
+		totals = append(totals, DailyTotal{Day: day, Total: servings.Total()})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Day.Before(totals[j].Day)
+	})
+	writeJSON(w, totals)
+}
+
+// dateRange parses the from and to parameters of the request, writing an error response if they are invalid.
+func dateRange(w http.ResponseWriter, r *http.Request) (from gocronometer.Date, to gocronometer.Date, ok bool) {
+	query := r.URL.Query()
+	for _, p := range []struct {
+		name string
+		date *gocronometer.Date
+	}{{"from", &from}, {"to", &to}} {
+		v := query.Get(p.name)
+		if v == "" {
+			continue
+		}
+
+		d, err := gocronometer.ParseDate(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid %s date: %s", p.name, err))
+			return from, to, false
+		}
+		*p.date = d
+	}
+
+	return from, to, true
+}
+
+// errorResponse is the body of error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeError writes the error as a JSON response with the status code.
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error()})
+}
+
+// writeJSON writes the value as a JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,86 @@
+package serve_test
+
+import (
+	"encoding/json"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/serve"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func date(day int) gocronometer.Date {
+	return gocronometer.Date{Year: 2021, Month: time.June, Day: day}
+}
+
+func newServer() *httptest.Server {
+	source := serve.NewMemorySource(
+		gocronometer.ServingRecords{
+			{Day: date(1), FoodName: "Toast", EnergyKcal: 150},
+			{Day: date(2), FoodName: "Eggs", EnergyKcal: 140},
+			{Day: date(2), FoodName: "Toast", EnergyKcal: 150},
+		},
+		nil,
+		gocronometer.BiometricRecords{
+			{Day: date(1), Metric: "Weight", Amount: 80},
+			{Day: date(1), Metric: "Heart Rate", Amount: 60},
+		},
+	)
+	return httptest.NewServer(serve.NewServer(source))
+}
+
+func get(t *testing.T, url string, v interface{}) int {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestServer_Servings(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	var servings gocronometer.ServingRecords
+	if status := get(t, server.URL+"/servings?from=2021-06-02", &servings); status != http.StatusOK {
+		t.Fatalf("unexpected status %d", status)
+	}
+	if len(servings) != 2 || servings[0].Day != date(2) {
+		t.Fatalf("unexpected servings: %+v", servings)
+	}
+
+	var errResp map[string]string
+	if status := get(t, server.URL+"/servings?to=June", &errResp); status != http.StatusBadRequest || errResp["error"] == "" {
+		t.Fatalf("expected a bad request but found %d %v", status, errResp)
+	}
+}
+
+func TestServer_DailyTotals(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	var totals []serve.DailyTotal
+	get(t, server.URL+"/daily-totals", &totals)
+	if len(totals) != 2 || totals[1].Day != date(2) || totals[1].Total.EnergyKcal != 290 {
+		t.Fatalf("unexpected totals: %+v", totals)
+	}
+}
+
+func TestServer_Biometrics(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	var biometrics gocronometer.BiometricRecords
+	get(t, server.URL+"/biometrics/heart%20rate", &biometrics)
+	if len(biometrics) != 1 || biometrics[0].Amount != 60 {
+		t.Fatalf("unexpected biometrics: %+v", biometrics)
+	}
+}
@@ -0,0 +1,67 @@
+package gocronometer
+
+import (
+	"sort"
+)
+
+// The exports provided by Cronometer are not guaranteed to be in any particular order. The following helpers sort the
+// record collections in place. All sorts are stable so records with equal keys retain their relative order.
+
+// SortByTime sorts the servings by RecordedTime, earliest first.
+func (s ServingRecords) SortByTime() {
+	sortByTime(s)
+}
+
+// SortByEnergy sorts the servings by EnergyKcal, lowest first.
+func (s ServingRecords) SortByEnergy() {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].EnergyKcal < s[j].EnergyKcal
+	})
+}
+
+// SortByName sorts the servings by FoodName.
+func (s ServingRecords) SortByName() {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].FoodName < s[j].FoodName
+	})
+}
+
+// SortByTime sorts the exercises by RecordedTime, earliest first.
+func (e ExerciseRecords) SortByTime() {
+	sortByTime(e)
+}
+
+// SortByEnergy sorts the exercises by CaloriesBurned, lowest first.
+func (e ExerciseRecords) SortByEnergy() {
+	sort.SliceStable(e, func(i, j int) bool {
+		return e[i].CaloriesBurned < e[j].CaloriesBurned
+	})
+}
+
+// SortByName sorts the exercises by the Exercise name.
+func (e ExerciseRecords) SortByName() {
+	sort.SliceStable(e, func(i, j int) bool {
+		return e[i].Exercise < e[j].Exercise
+	})
+}
+
+// SortByTime sorts the biometrics by RecordedTime, earliest first.
+func (b BiometricRecords) SortByTime() {
+	sortByTime(b)
+}
+
+// SortByAmount sorts the biometrics by Amount, lowest first. Biometrics have no energy value so this is the
+// equivalent of SortByEnergy on the other collections. Mixing metrics is rarely useful so this is most often combined
+// with a filter on Metric.
+func (b BiometricRecords) SortByAmount() {
+	sort.SliceStable(b, func(i, j int) bool {
+		return b[i].Amount < b[j].Amount
+	})
+}
+
+// SortByName sorts the biometrics by Metric.
+func (b BiometricRecords) SortByName() {
+	sort.SliceStable(b, func(i, j int) bool {
+		return b[i].Metric < b[j].Metric
+	})
+}
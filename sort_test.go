@@ -0,0 +1,55 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+	"time"
+)
+
+func TestServingRecords_SortByTime(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Dinner", RecordedTime: time.Date(2021, 6, 1, 18, 0, 0, 0, time.UTC)},
+		{FoodName: "Breakfast", RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)},
+		{FoodName: "Snack", RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC)},
+	}
+
+	servings.SortByTime()
+
+	expected := []string{"Breakfast", "Snack", "Dinner"}
+	for i, name := range expected {
+		if servings[i].FoodName != name {
+			t.Fatalf("expected %s at index %d but found %s", name, i, servings[i].FoodName)
+		}
+	}
+}
+
+func TestServingRecords_SortByEnergy(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Bread", EnergyKcal: 200},
+		{FoodName: "Apple", EnergyKcal: 95},
+		{FoodName: "Butter", EnergyKcal: 200},
+	}
+
+	servings.SortByEnergy()
+
+	expected := []string{"Apple", "Bread", "Butter"}
+	for i, name := range expected {
+		if servings[i].FoodName != name {
+			t.Fatalf("expected %s at index %d but found %s", name, i, servings[i].FoodName)
+		}
+	}
+}
+
+func TestBiometricRecords_SortByName(t *testing.T) {
+	records := gocronometer.BiometricRecords{
+		{Metric: "Weight", Amount: 80},
+		{Metric: "Heart Rate", Amount: 60},
+		{Metric: "Weight", Amount: 79},
+	}
+
+	records.SortByName()
+
+	if records[0].Metric != "Heart Rate" || records[1].Amount != 80 || records[2].Amount != 79 {
+		t.Fatalf("unexpected order after sort: %+v", records)
+	}
+}
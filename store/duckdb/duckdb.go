@@ -0,0 +1,38 @@
+// Package duckdb implements a store.Store in a DuckDB database, allowing analytical SQL to be run over the full
+// history of records locally without a database server. The package uses database/sql and does not import a driver,
+// as the DuckDB driver requires cgo, so the driver must be imported by the program, for example:
+//
+//	import _ "github.com/marcboeker/go-duckdb"
+//
+//	db, err := sql.Open("duckdb", "cronometer.duckdb")
+//	s := duckdb.New(db)
+//	err = s.Migrate(ctx)
+//
+// The nutrients of the servings are stored as a JSON object keyed by nutrient name, which can be queried with the
+// JSON functions of DuckDB:
+//
+//	SELECT day, sum(CAST(nutrients->>'Protein' AS DOUBLE)) FROM servings GROUP BY day ORDER BY day
+package duckdb
+
+import (
+	"database/sql"
+	"embed"
+	"github.com/burke/gocronometer/store/internal/sqlstore"
+)
+
+// migrations holds the schema migrations. Each file is named after its version, such as 0001_create_records.sql, and
+// is applied once in order of its version.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Store is a store.Store in a DuckDB database. DuckDB databases are opened by a single process, so migrations are
+// not locked.
+type Store struct {
+	*sqlstore.Store
+}
+
+// New creates a Store in the database. Migrate must be called before the store is used.
+func New(db *sql.DB) *Store {
+	return &Store{Store: sqlstore.New(db, migrations, "")}
+}
@@ -0,0 +1,47 @@
+CREATE TABLE servings (
+    key             VARCHAR     NOT NULL,
+    occurrence      INTEGER     NOT NULL,
+    recorded_time   TIMESTAMPTZ NOT NULL,
+    recorded_zone   VARCHAR     NOT NULL,
+    recorded_offset INTEGER     NOT NULL,
+    day             DATE        NOT NULL,
+    has_time        BOOLEAN     NOT NULL,
+    meal_group      VARCHAR     NOT NULL,
+    food_name       VARCHAR     NOT NULL,
+    source          VARCHAR     NOT NULL,
+    food_id         VARCHAR     NOT NULL,
+    quantity_value  DOUBLE      NOT NULL,
+    quantity_units  VARCHAR     NOT NULL,
+    category        VARCHAR     NOT NULL,
+    -- The amounts of the nutrients as a JSON object keyed by nutrient name, such as {"Energy": 140}.
+    nutrients       VARCHAR     NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
+
+CREATE TABLE exercises (
+    key             VARCHAR     NOT NULL,
+    occurrence      INTEGER     NOT NULL,
+    recorded_time   TIMESTAMPTZ NOT NULL,
+    recorded_zone   VARCHAR     NOT NULL,
+    recorded_offset INTEGER     NOT NULL,
+    day             DATE        NOT NULL,
+    has_time        BOOLEAN     NOT NULL,
+    exercise        VARCHAR     NOT NULL,
+    minutes         DOUBLE      NOT NULL,
+    calories_burned DOUBLE      NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
+
+CREATE TABLE biometrics (
+    key             VARCHAR     NOT NULL,
+    occurrence      INTEGER     NOT NULL,
+    recorded_time   TIMESTAMPTZ NOT NULL,
+    recorded_zone   VARCHAR     NOT NULL,
+    recorded_offset INTEGER     NOT NULL,
+    day             DATE        NOT NULL,
+    has_time        BOOLEAN     NOT NULL,
+    metric          VARCHAR     NOT NULL,
+    unit            VARCHAR     NOT NULL,
+    amount          DOUBLE      NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
@@ -0,0 +1,318 @@
+// Package sqlstore implements a store.Store with database/sql, so the same queries are shared by the stores of the
+// databases supporting them. The databases differ in their schema migrations and in how migrations are locked.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/store"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Store is a store.Store in a database accessed with database/sql. The database must support upserts with ON
+// CONFLICT and numbered placeholders, such as $1.
+type Store struct {
+	db *sql.DB
+
+	// migrations holds the schema migrations in its migrations directory. Each file is named after its version, such
+	// as 0001_create_records.sql, and is applied once in order of its version.
+	migrations fs.FS
+
+	// lock is executed at the start of the migration transaction to prevent migrations running concurrently. It is
+	// not executed when empty.
+	lock string
+}
+
+var _ store.Store = (*Store)(nil)
+
+// New creates a Store in the database with the migrations provided. The lock statement is executed before migrating
+// unless it is empty.
+func New(db *sql.DB, migrations fs.FS, lock string) *Store {
+	return &Store{db: db, migrations: migrations, lock: lock}
+}
+
+// Migrate applies the migrations that have not been applied to the database. The versions applied are recorded in
+// the gocronometer_migrations table.
+func (s *Store) Migrate(ctx context.Context) error {
+	files, err := fs.Glob(s.migrations, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("listing migrations: %w", err)
+	}
+	sort.Strings(files)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning migration: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer tx.Rollback()
+
+	if s.lock != "" {
+		if _, err := tx.ExecContext(ctx, s.lock); err != nil {
+			return fmt.Errorf("locking migrations: %w", err)
+		}
+	}
+	_, err = tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS gocronometer_migrations (
+		version    integer     PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("creating migrations table: %w", err)
+	}
+
+	for _, file := range files {
+		name := strings.TrimPrefix(file, "migrations/")
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("parsing version of migration %s: %w", name, err)
+		}
+
+		var applied bool
+		err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM gocronometer_migrations WHERE version = $1)", version).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("checking migration %s: %w", name, err)
+		}
+		if applied {
+			continue
+		}
+
+		migration, err := fs.ReadFile(s.migrations, file)
+		if err != nil {
+			return fmt.Errorf("reading migration %s: %w", name, err)
+		}
+		if _, err := tx.ExecContext(ctx, string(migration)); err != nil {
+			return fmt.Errorf("applying migration %s: %w", name, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO gocronometer_migrations (version) VALUES ($1)", version); err != nil {
+			return fmt.Errorf("recording migration %s: %w", name, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// PutServings upserts the servings in a single transaction.
+func (s *Store) PutServings(ctx context.Context, servings gocronometer.ServingRecords) error {
+	ids := store.IDs(servings)
+	return s.put(ctx, len(servings), `INSERT INTO servings (key, occurrence, recorded_time, day, has_time, meal_group,
+		food_name, source, food_id, quantity_value, quantity_units, category, nutrients, recorded_zone, recorded_offset)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (key, occurrence) DO UPDATE SET recorded_time = EXCLUDED.recorded_time,
+		category = EXCLUDED.category, nutrients = EXCLUDED.nutrients, recorded_zone = EXCLUDED.recorded_zone,
+		recorded_offset = EXCLUDED.recorded_offset`,
+		func(i int) ([]interface{}, error) {
+			r := servings[i]
+			nutrients := map[string]float64{}
+			for _, n := range gocronometer.Nutrients() {
+				if v := n.Value(r); v != 0 {
+					nutrients[n.Name] = v
+				}
+			}
+			encoded, err := json.Marshal(nutrients)
+			if err != nil {
+				return nil, err
+			}
+
+			name, offset := zone(r.RecordedTime)
+			return []interface{}{ids[i].Key, ids[i].Occurrence, r.RecordedTime, r.Day.String(), r.HasTime, r.Group,
+				r.FoodName, r.Source, r.FoodID, r.QuantityValue, r.QuantityUnits, r.Category, string(encoded), name,
+				offset}, nil
+		})
+}
+
+// PutExercises upserts the exercises in a single transaction.
+func (s *Store) PutExercises(ctx context.Context, exercises gocronometer.ExerciseRecords) error {
+	ids := store.IDs(exercises)
+	return s.put(ctx, len(exercises), `INSERT INTO exercises (key, occurrence, recorded_time, day, has_time, exercise,
+		minutes, calories_burned, recorded_zone, recorded_offset)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (key, occurrence) DO UPDATE SET recorded_time = EXCLUDED.recorded_time,
+		recorded_zone = EXCLUDED.recorded_zone, recorded_offset = EXCLUDED.recorded_offset`,
+		func(i int) ([]interface{}, error) {
+			r := exercises[i]
+			name, offset := zone(r.RecordedTime)
+			return []interface{}{ids[i].Key, ids[i].Occurrence, r.RecordedTime, r.Day.String(), r.HasTime, r.Exercise,
+				r.Minutes, r.CaloriesBurned, name, offset}, nil
+		})
+}
+
+// PutBiometrics upserts the biometrics in a single transaction.
+func (s *Store) PutBiometrics(ctx context.Context, biometrics gocronometer.BiometricRecords) error {
+	ids := store.IDs(biometrics)
+	return s.put(ctx, len(biometrics), `INSERT INTO biometrics (key, occurrence, recorded_time, day, has_time, metric,
+		unit, amount, recorded_zone, recorded_offset)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (key, occurrence) DO UPDATE SET recorded_time = EXCLUDED.recorded_time,
+		recorded_zone = EXCLUDED.recorded_zone, recorded_offset = EXCLUDED.recorded_offset`,
+		func(i int) ([]interface{}, error) {
+			r := biometrics[i]
+			name, offset := zone(r.RecordedTime)
+			return []interface{}{ids[i].Key, ids[i].Occurrence, r.RecordedTime, r.Day.String(), r.HasTime, r.Metric,
+				r.Unit, r.Amount, name, offset}, nil
+		})
+}
+
+// put executes the upsert for each of the n records in a transaction, with the arguments returned by args.
+func (s *Store) put(ctx context.Context, n int, upsert string, args func(i int) ([]interface{}, error)) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, upsert)
+	if err != nil {
+		return fmt.Errorf("preparing upsert: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer stmt.Close()
+
+	for i := 0; i < n; i++ {
+		a, err := args(i)
+		if err != nil {
+			return fmt.Errorf("encoding record %d: %w", i, err)
+		}
+		if _, err := stmt.ExecContext(ctx, a...); err != nil {
+			return fmt.Errorf("upserting record %d: %w", i, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Servings returns the servings in the range sorted by time.
+func (s *Store) Servings(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ServingRecords, error) {
+	records := gocronometer.ServingRecords{}
+	err := s.query(ctx, `SELECT recorded_time, recorded_zone, recorded_offset, day, has_time, meal_group, food_name,
+		source, food_id, quantity_value, quantity_units, category, nutrients FROM servings`, from, to, func(rows *sql.Rows) error {
+		var r gocronometer.ServingRecord
+		var day time.Time
+		var name string
+		var offset int
+		var nutrients []byte
+		err := rows.Scan(&r.RecordedTime, &name, &offset, &day, &r.HasTime, &r.Group, &r.FoodName, &r.Source,
+			&r.FoodID, &r.QuantityValue, &r.QuantityUnits, &r.Category, &nutrients)
+		if err != nil {
+			return err
+		}
+		r.RecordedTime, r.Day = inZone(r.RecordedTime, name, offset), toDate(day)
+
+		values := map[string]float64{}
+		if err := json.Unmarshal(nutrients, &values); err != nil {
+			return fmt.Errorf("decoding nutrients: %w", err)
+		}
+		for name, v := range values {
+			if n, ok := gocronometer.LookupNutrient(name); ok {
+				n.Set(&r, v)
+			}
+		}
+
+		records = append(records, r)
+		return nil
+	})
+	return records, err
+}
+
+// Exercises returns the exercises in the range sorted by time.
+func (s *Store) Exercises(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.ExerciseRecords, error) {
+	records := gocronometer.ExerciseRecords{}
+	err := s.query(ctx, `SELECT recorded_time, recorded_zone, recorded_offset, day, has_time, exercise, minutes,
+		calories_burned FROM exercises`, from, to, func(rows *sql.Rows) error {
+		var r gocronometer.ExerciseRecord
+		var day time.Time
+		var name string
+		var offset int
+		err := rows.Scan(&r.RecordedTime, &name, &offset, &day, &r.HasTime, &r.Exercise, &r.Minutes, &r.CaloriesBurned)
+		if err != nil {
+			return err
+		}
+		r.RecordedTime, r.Day = inZone(r.RecordedTime, name, offset), toDate(day)
+		records = append(records, r)
+		return nil
+	})
+	return records, err
+}
+
+// Biometrics returns the biometrics in the range sorted by time.
+func (s *Store) Biometrics(ctx context.Context, from gocronometer.Date, to gocronometer.Date) (gocronometer.BiometricRecords, error) {
+	records := gocronometer.BiometricRecords{}
+	err := s.query(ctx, `SELECT recorded_time, recorded_zone, recorded_offset, day, has_time, metric, unit, amount
+		FROM biometrics`, from, to, func(rows *sql.Rows) error {
+		var r gocronometer.BiometricRecord
+		var day time.Time
+		var name string
+		var offset int
+		if err := rows.Scan(&r.RecordedTime, &name, &offset, &day, &r.HasTime, &r.Metric, &r.Unit, &r.Amount); err != nil {
+			return err
+		}
+		r.RecordedTime, r.Day = inZone(r.RecordedTime, name, offset), toDate(day)
+		records = append(records, r)
+		return nil
+	})
+	return records, err
+}
+
+// query selects the rows of the range, in the order they were recorded, and calls scan for each row. Zero dates leave
+// the range unbounded on that side.
+func (s *Store) query(ctx context.Context, selection string, from gocronometer.Date, to gocronometer.Date, scan func(rows *sql.Rows) error) error {
+	rows, err := s.db.QueryContext(ctx, selection+` WHERE ($1::date IS NULL OR day >= $1::date)
+		AND ($2::date IS NULL OR day <= $2::date) ORDER BY recorded_time, key, occurrence`, nullDate(from), nullDate(to))
+	if err != nil {
+		return fmt.Errorf("querying records: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("scanning record: %w", err)
+		}
+	}
+	return rows.Err()
+}
+
+// nullDate converts the date to a query argument, converting the zero date to NULL.
+func nullDate(d gocronometer.Date) interface{} {
+	if d.IsZero() {
+		return nil
+	}
+	return d.String()
+}
+
+// toDate converts a date column to a date. The time of day and location are ignored.
+func toDate(t time.Time) gocronometer.Date {
+	return gocronometer.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+// zone returns the name of the location of the time and its offset from UTC in seconds. They are stored with the time,
+// as the databases only keep its instant, so the time is read back in its location and the keys of the records, which
+// depend on the time of day, do not change.
+func zone(t time.Time) (string, int) {
+	_, offset := t.Zone()
+	return t.Location().String(), offset
+}
+
+// inZone converts the time read from the database to the location stored with it by zone. Locations that cannot be
+// loaded by name, such as fixed zones, are rebuilt from the offset.
+func inZone(t time.Time, name string, offset int) time.Time {
+	if location, err := time.LoadLocation(name); err == nil && name != "" {
+		if local := t.In(location); zoneOffset(local) == offset {
+			return local
+		}
+	}
+	return t.In(time.FixedZone(name, offset))
+}
+
+// zoneOffset returns the offset of the time from UTC in seconds.
+func zoneOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
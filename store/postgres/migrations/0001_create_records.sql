@@ -0,0 +1,52 @@
+CREATE TABLE servings (
+    key             text             NOT NULL,
+    occurrence      integer          NOT NULL,
+    recorded_time   timestamptz      NOT NULL,
+    recorded_zone   text             NOT NULL,
+    recorded_offset integer          NOT NULL,
+    day             date             NOT NULL,
+    has_time        boolean          NOT NULL,
+    meal_group      text             NOT NULL,
+    food_name       text             NOT NULL,
+    source          text             NOT NULL,
+    food_id         text             NOT NULL,
+    quantity_value  double precision NOT NULL,
+    quantity_units  text             NOT NULL,
+    category        text             NOT NULL,
+    nutrients       jsonb            NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
+
+CREATE INDEX servings_day ON servings (day);
+
+CREATE TABLE exercises (
+    key             text             NOT NULL,
+    occurrence      integer          NOT NULL,
+    recorded_time   timestamptz      NOT NULL,
+    recorded_zone   text             NOT NULL,
+    recorded_offset integer          NOT NULL,
+    day             date             NOT NULL,
+    has_time        boolean          NOT NULL,
+    exercise        text             NOT NULL,
+    minutes         double precision NOT NULL,
+    calories_burned double precision NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
+
+CREATE INDEX exercises_day ON exercises (day);
+
+CREATE TABLE biometrics (
+    key             text             NOT NULL,
+    occurrence      integer          NOT NULL,
+    recorded_time   timestamptz      NOT NULL,
+    recorded_zone   text             NOT NULL,
+    recorded_offset integer          NOT NULL,
+    day             date             NOT NULL,
+    has_time        boolean          NOT NULL,
+    metric          text             NOT NULL,
+    unit            text             NOT NULL,
+    amount          double precision NOT NULL,
+    PRIMARY KEY (key, occurrence)
+);
+
+CREATE INDEX biometrics_day ON biometrics (day);
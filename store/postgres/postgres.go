@@ -0,0 +1,35 @@
+// Package postgres implements a store.Store in a PostgreSQL database. The package uses database/sql and does not
+// import a driver, so the driver must be imported by the program, for example:
+//
+//	import _ "github.com/lib/pq"
+//
+//	db, err := sql.Open("postgres", dsn)
+//	s := postgres.New(db)
+//	err = s.Migrate(ctx)
+package postgres
+
+import (
+	"database/sql"
+	"embed"
+	"github.com/burke/gocronometer/store/internal/sqlstore"
+)
+
+// migrations holds the schema migrations. Each file is named after its version, such as 0001_create_records.sql, and
+// is applied once in order of its version.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// migrationLock takes an advisory lock for the migration transaction, so stores migrating the same database at the
+// same time apply each migration once.
+const migrationLock = "SELECT pg_advisory_xact_lock(7245901)"
+
+// Store is a store.Store in a PostgreSQL database.
+type Store struct {
+	*sqlstore.Store
+}
+
+// New creates a Store in the database. Migrate must be called before the store is used.
+func New(db *sql.DB) *Store {
+	return &Store{Store: sqlstore.New(db, migrations, migrationLock)}
+}
@@ -0,0 +1,128 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/store/postgres"
+	_ "github.com/lib/pq"
+	"os"
+	"testing"
+	"time"
+)
+
+// openStore opens a migrated store in the database of GOCRONOMETER_TEST_POSTGRES_DSN, skipping the test when it is
+// not set. The tables of the store are emptied so each test starts without records.
+func openStore(t *testing.T) *postgres.Store {
+	dsn := os.Getenv("GOCRONOMETER_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("GOCRONOMETER_TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	s := postgres.New(db)
+	// Migrating twice must leave the schema unchanged.
+	for i := 0; i < 2; i++ {
+		if err := s.Migrate(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec("TRUNCATE servings, exercises, biometrics"); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestStore_PutServings(t *testing.T) {
+	s := openStore(t)
+	ctx := context.Background()
+
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	eggs := gocronometer.ServingRecord{RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, time.UTC), Day: day, HasTime: true,
+		Group: "Breakfast", FoodName: "Eggs", QuantityValue: 2, QuantityUnits: "large", EnergyKcal: 140}
+	servings := gocronometer.ServingRecords{eggs, eggs}
+
+	// Writing the same servings twice must not duplicate them.
+	for i := 0; i < 2; i++ {
+		if err := s.PutServings(ctx, servings); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stored, err := s.Servings(ctx, day, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.Equal(servings) {
+		t.Fatalf("expected %+v but found %+v", servings, stored)
+	}
+
+	stored, err = s.Servings(ctx, day.AddDays(1), gocronometer.Date{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 0 {
+		t.Fatalf("expected no servings after the day but found %+v", stored)
+	}
+}
+
+func TestStore_PutBiometrics(t *testing.T) {
+	s := openStore(t)
+	ctx := context.Background()
+
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	biometrics := gocronometer.BiometricRecords{{RecordedTime: day.Time(time.UTC), Day: day, Metric: "Weight", Unit: "kg", Amount: 80}}
+	if err := s.PutBiometrics(ctx, biometrics); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := s.Biometrics(ctx, gocronometer.Date{}, gocronometer.Date{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.Equal(biometrics) {
+		t.Fatalf("expected %+v but found %+v", biometrics, stored)
+	}
+}
+
+func TestStore_PutStoredServings(t *testing.T) {
+	s := openStore(t)
+	ctx := context.Background()
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	servings := gocronometer.ServingRecords{{RecordedTime: time.Date(2021, 6, 1, 8, 0, 0, 0, location), Day: day,
+		HasTime: true, Group: "Breakfast", FoodName: "Eggs", QuantityValue: 2, QuantityUnits: "large"}}
+	if err := s.PutServings(ctx, servings); err != nil {
+		t.Fatal(err)
+	}
+
+	// Servings read back must keep the time of day of their location, so writing them again does not change their key
+	// and duplicate them.
+	stored, err := s.Servings(ctx, gocronometer.Date{}, gocronometer.Date{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0].Key() != servings[0].Key() || stored[0].RecordedTime.Location().String() != "America/New_York" {
+		t.Fatalf("expected %+v but found %+v", servings, stored)
+	}
+	if err := s.PutServings(ctx, stored); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err = s.Servings(ctx, gocronometer.Date{}, gocronometer.Date{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("expected 1 serving after writing the stored servings but found %d", len(stored))
+	}
+}
@@ -0,0 +1,78 @@
+// Package store defines the interface of databases holding parsed Cronometer records, allowing sync jobs on several
+// devices to share their records. Implementations are found in the subpackages, such as store/postgres and
+// store/duckdb.
+//
+// Records are identified by their Key along with their occurrence, the number of records with the same key before
+// them in the records written. Writing the same records again, such as when an export is repeated, updates the stored
+// records instead of duplicating them, while the same food logged twice with the same amount at the same time is
+// stored twice.
+package store
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/serve"
+)
+
+// Store holds parsed records. It is a serve.Source so the stored records can be served over HTTP and gRPC.
+type Store interface {
+	serve.Source
+
+	// PutServings inserts the servings, updating the servings already stored with the same ID.
+	PutServings(ctx context.Context, servings gocronometer.ServingRecords) error
+
+	// PutExercises inserts the exercises, updating the exercises already stored with the same ID.
+	PutExercises(ctx context.Context, exercises gocronometer.ExerciseRecords) error
+
+	// PutBiometrics inserts the biometrics, updating the biometrics already stored with the same ID.
+	PutBiometrics(ctx context.Context, biometrics gocronometer.BiometricRecords) error
+}
+
+// ID identifies a stored record.
+type ID struct {
+	// Key is the Key of the record.
+	Key string
+
+	// Occurrence is the number of records with the same key before the record in the records written.
+	Occurrence int
+}
+
+// IDs returns the ID of each record in order.
+func IDs[S ~[]E, E interface{ Key() string }](records S) []ID {
+	seen := make(map[string]int, len(records))
+	ids := make([]ID, len(records))
+	for i, record := range records {
+		key := record.Key()
+		ids[i] = ID{Key: key, Occurrence: seen[key]}
+		seen[key]++
+	}
+
+	return ids
+}
+
+// exporter adapts a Store to a gocronometer.Exporter.
+type exporter struct {
+	ctx   context.Context
+	store Store
+}
+
+// NewExporter adapts the store to a gocronometer.Exporter, so the store can be used as a sink in place of the CSV and
+// JSON exporters. The records are written with ctx.
+func NewExporter(ctx context.Context, s Store) gocronometer.Exporter {
+	return &exporter{ctx: ctx, store: s}
+}
+
+// WriteServings puts the servings in the store.
+func (e *exporter) WriteServings(servings gocronometer.ServingRecords) error {
+	return e.store.PutServings(e.ctx, servings)
+}
+
+// WriteExercises puts the exercises in the store.
+func (e *exporter) WriteExercises(exercises gocronometer.ExerciseRecords) error {
+	return e.store.PutExercises(e.ctx, exercises)
+}
+
+// WriteBiometrics puts the biometrics in the store.
+func (e *exporter) WriteBiometrics(biometrics gocronometer.BiometricRecords) error {
+	return e.store.PutBiometrics(e.ctx, biometrics)
+}
@@ -0,0 +1,57 @@
+package store_test
+
+import (
+	"context"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/serve"
+	"github.com/burke/gocronometer/store"
+	"testing"
+)
+
+func TestIDs(t *testing.T) {
+	eggs := gocronometer.ServingRecord{FoodName: "Eggs", QuantityValue: 2, QuantityUnits: "large"}
+	rice := gocronometer.ServingRecord{FoodName: "Rice", QuantityValue: 1, QuantityUnits: "cup"}
+
+	ids := store.IDs(gocronometer.ServingRecords{eggs, rice, eggs})
+	if len(ids) != 3 {
+		t.Fatalf("expected 3 IDs but found %d", len(ids))
+	}
+	if ids[0].Key != eggs.Key() || ids[0].Occurrence != 0 || ids[1].Occurrence != 0 {
+		t.Fatalf("unexpected IDs: %+v", ids)
+	}
+	if ids[2].Key != ids[0].Key || ids[2].Occurrence != 1 {
+		t.Fatalf("expected the repeated serving to be the second occurrence but found %+v", ids[2])
+	}
+}
+
+// recordingStore is a store.Store recording the biometrics put.
+type recordingStore struct {
+	*serve.MemorySource
+	biometrics gocronometer.BiometricRecords
+}
+
+func (r *recordingStore) PutServings(context.Context, gocronometer.ServingRecords) error {
+	return nil
+}
+
+func (r *recordingStore) PutExercises(context.Context, gocronometer.ExerciseRecords) error {
+	return nil
+}
+
+func (r *recordingStore) PutBiometrics(_ context.Context, biometrics gocronometer.BiometricRecords) error {
+	r.biometrics = append(r.biometrics, biometrics...)
+	return nil
+}
+
+func TestNewExporter(t *testing.T) {
+	s := &recordingStore{MemorySource: serve.NewMemorySource(nil, nil, nil)}
+	exporter := store.NewExporter(context.Background(), s)
+
+	biometrics := gocronometer.BiometricRecords{{Metric: "Weight", Amount: 80}}
+	if err := exporter.WriteBiometrics(biometrics); err != nil {
+		t.Fatal(err)
+	}
+	if !s.biometrics.Equal(biometrics) {
+		t.Fatalf("expected %+v to be put but found %+v", biometrics, s.biometrics)
+	}
+}
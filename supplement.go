@@ -0,0 +1,85 @@
+package gocronometer
+
+import (
+	"strings"
+)
+
+// SupplementRule matches the servings of supplements. Every field of the rule that is set must be found in the field of
+// the serving with the same name, without regard to case, so SupplementRule{FoodName: "fish oil"} matches "Fish Oil,
+// Omega-3". A rule without any field set matches nothing.
+type SupplementRule struct {
+	Category string
+	Group    string
+	FoodName string
+
+	// Units matches QuantityUnits.
+	Units string
+}
+
+// DefaultSupplementRules are the rules used by IsSupplement when none are provided. They match the Supplements category
+// and diary groups named after supplements, servings measured in capsules, tablets or softgels, and the names of common
+// supplements. Rules are added by appending to a copy:
+//
+//	rules := append(slices.Clone(gocronometer.DefaultSupplementRules), gocronometer.SupplementRule{FoodName: "Athletic Greens"})
+var DefaultSupplementRules = []SupplementRule{
+	{Category: "supplement"},
+	{Group: "supplement"},
+	{Units: "capsule"},
+	{Units: "tablet"},
+	{Units: "softgel"},
+	{Units: "pill"},
+	{FoodName: "multivitamin"},
+	{FoodName: "supplement"},
+	{FoodName: "fish oil"},
+	{FoodName: "creatine"},
+	{FoodName: "probiotic"},
+	{FoodName: "melatonin"},
+	{FoodName: "electrolyte"},
+}
+
+// Matches reports whether the serving matches the rule.
+func (r SupplementRule) Matches(s ServingRecord) bool {
+	if r == (SupplementRule{}) {
+		return false
+	}
+
+	return containsFold(s.Category, r.Category) && containsFold(s.Group, r.Group) &&
+		containsFold(s.FoodName, r.FoodName) && containsFold(s.QuantityUnits, r.Units)
+}
+
+// IsSupplement reports whether the serving is a supplement rather than a food by the rules, which default to
+// DefaultSupplementRules when nil. The rules are a heuristic, as Cronometer does not export whether a food is a
+// supplement unless it is in the Supplements category.
+func (s ServingRecord) IsSupplement(rules []SupplementRule) bool {
+	if rules == nil {
+		rules = DefaultSupplementRules
+	}
+
+	for _, r := range rules {
+		if r.Matches(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitSupplements splits the servings into the foods and the supplements by IsSupplement, so the nutrients obtained
+// from food can be analysed apart from those supplemented. The servings retain their order.
+func (s ServingRecords) SplitSupplements(rules []SupplementRule) (foods ServingRecords, supplements ServingRecords) {
+	foods, supplements = make(ServingRecords, 0), make(ServingRecords, 0)
+	for _, serving := range s {
+		if serving.IsSupplement(rules) {
+			supplements = append(supplements, serving)
+		} else {
+			foods = append(foods, serving)
+		}
+	}
+
+	return foods, supplements
+}
+
+// containsFold reports whether substr is found in s without regard to case. An empty substr is always found.
+func containsFold(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,38 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestServingRecords_SplitSupplements(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Eggs", Group: "Breakfast", QuantityUnits: "large", ProteinG: 12},
+		{FoodName: "Vitamin D3", Category: "Supplements", QuantityUnits: "IU", VitaminDUI: 2000},
+		{FoodName: "Magnesium Glycinate", QuantityUnits: "Capsule", MagnesiumMg: 200},
+		{FoodName: "Nordic Naturals Fish Oil", QuantityUnits: "g"},
+		{FoodName: "Greens Powder", Group: "Snacks", QuantityUnits: "scoop"},
+	}
+
+	foods, supplements := servings.SplitSupplements(nil)
+	if len(foods) != 2 || len(supplements) != 3 || foods[0].FoodName != "Eggs" || supplements[0].FoodName != "Vitamin D3" {
+		t.Fatalf("unexpected split: foods %+v, supplements %+v", foods, supplements)
+	}
+
+	rules := append(append([]gocronometer.SupplementRule(nil), gocronometer.DefaultSupplementRules...),
+		gocronometer.SupplementRule{FoodName: "greens", Units: "scoop"})
+	if !servings[4].IsSupplement(rules) {
+		t.Fatalf("expected the added rule to match the greens powder")
+	}
+	if servings[4].IsSupplement(nil) {
+		t.Fatalf("expected the default rules to not match the greens powder")
+	}
+
+	if (gocronometer.SupplementRule{}).Matches(servings[0]) {
+		t.Fatalf("expected an empty rule to match nothing")
+	}
+
+	if food := foods.Total().VitaminDUI; food != 0 {
+		t.Fatalf("expected no vitamin D from food but found %f", food)
+	}
+}
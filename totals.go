@@ -0,0 +1,23 @@
+package gocronometer
+
+// Total sums every nutrient of the servings into a single record. Only the nutrient fields are set on the result, as
+// the other fields have no meaningful total. It is most often used with GroupBy to calculate daily totals:
+//
+//	for day, servings := range gocronometer.GroupBy(servings, func(s gocronometer.ServingRecord) gocronometer.Date {
+//		return s.Day
+//	}) {
+//		fmt.Println(day, servings.Total().EnergyKcal)
+//	}
+func (s ServingRecords) Total() ServingRecord {
+	var total ServingRecord
+	for i := range s {
+		for _, n := range nutrients {
+			*n.field(&total) += *n.field(&s[i])
+		}
+	}
+
+	// The deprecated fields are kept in sync for compatibility.
+	total.B12Mg, total.VitaminKMg = total.B12Ug, total.VitaminKUg
+
+	return total
+}
@@ -0,0 +1,22 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"testing"
+)
+
+func TestServingRecords_Total(t *testing.T) {
+	servings := gocronometer.ServingRecords{
+		{FoodName: "Eggs", EnergyKcal: 143, ProteinG: 12.6, VitaminKUg: 0.6},
+		{FoodName: "Spinach", EnergyKcal: 7, ProteinG: 0.9, VitaminKUg: 145},
+	}
+
+	total := servings.Total()
+	if total.EnergyKcal != 150 || total.ProteinG != 13.5 || total.VitaminKUg != 145.6 {
+		t.Fatalf("unexpected totals: %+v", total)
+	}
+
+	if total.FoodName != "" {
+		t.Fatalf("expected the food name to not be set on the total")
+	}
+}
@@ -0,0 +1,118 @@
+package gocronometer
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultTrendSmoothing is the default TrendOptions.Smoothing, as used by The Hacker's Diet.
+const DefaultTrendSmoothing = 0.1
+
+// TrendOptions configures the trend built by Trend and WeightTrend. Zero values revert to the library defaults.
+type TrendOptions struct {
+	// Smoothing is the fraction of the difference between the amount of a day and the trend of the previous day that
+	// is added to the trend each day. Smaller values smooth out more of the daily fluctuations at the cost of reacting
+	// slower to real changes. Defaults to DefaultTrendSmoothing.
+	Smoothing float64
+
+	// MaxGapDays is the longest gap in days between recordings that is filled by interpolation. The trend restarts
+	// from the first recording after a longer gap, such as a vacation without a scale, and no points are returned for
+	// the days of the gap. Defaults to filling every gap.
+	MaxGapDays int
+
+	// ExcludeOutliers excludes the recordings flagged by Outliers with these options from the trend. Outliers are
+	// included when nil.
+	ExcludeOutliers *OutlierOptions
+}
+
+// TrendPoint is the trend of a metric on a day.
+type TrendPoint struct {
+	Day Date
+
+	// Amount is the average of the recordings of the day, or the amount interpolated between the surrounding
+	// recordings when Interpolated is true.
+	Amount float64
+
+	// Trend is the exponentially smoothed amount.
+	Trend float64
+
+	Interpolated bool
+}
+
+// WeightTrend builds the trend of the weight biometrics. It is Trend of the "Weight" metric.
+func (b BiometricRecords) WeightTrend(opts *TrendOptions) []TrendPoint {
+	return b.Trend("Weight", opts)
+}
+
+// Trend builds the exponentially smoothed trend of the metric with a point for each day, earliest first. Unlike a
+// moving average, the trend is not skewed by irregular recordings: several recordings on a day are averaged, and days
+// without a recording are linearly interpolated between the surrounding recordings. Metrics are matched without
+// regard to case and only recordings in the unit of the first recording are included. A nil opts uses the defaults.
+func (b BiometricRecords) Trend(metric string, opts *TrendOptions) []TrendPoint {
+	if opts == nil {
+		opts = &TrendOptions{}
+	}
+	smoothing := opts.Smoothing
+	if smoothing == 0 {
+		smoothing = DefaultTrendSmoothing
+	}
+
+	excluded := map[int]bool{}
+	if opts.ExcludeOutliers != nil {
+		for _, o := range b.Outliers(metric, opts.ExcludeOutliers) {
+			excluded[o.Index] = true
+		}
+	}
+
+	sums := map[Date]float64{}
+	counts := map[Date]int{}
+	for _, i := range b.metricIndexes(metric) {
+		if excluded[i] {
+			continue
+		}
+		sums[b[i].Day] += b[i].Amount
+		counts[b[i].Day]++
+	}
+
+	days := make([]Date, 0, len(counts))
+	for d := range counts {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+
+	points := make([]TrendPoint, 0, len(days))
+	var trend float64
+	for i, day := range days {
+		amount := sums[day] / float64(counts[day])
+
+		if i == 0 {
+			trend = amount
+		} else {
+			previous := days[i-1]
+			previousAmount := sums[previous] / float64(counts[previous])
+			gap := daysBetween(previous, day)
+
+			if opts.MaxGapDays > 0 && gap-1 > opts.MaxGapDays {
+				trend = amount
+			} else {
+				for d := 1; d < gap; d++ {
+					interpolated := previousAmount + (amount-previousAmount)*float64(d)/float64(gap)
+					trend += smoothing * (interpolated - trend)
+					points = append(points, TrendPoint{Day: previous.AddDays(d), Amount: interpolated, Trend: trend, Interpolated: true})
+				}
+				trend += smoothing * (amount - trend)
+			}
+		}
+
+		points = append(points, TrendPoint{Day: day, Amount: amount, Trend: trend})
+	}
+
+	return points
+}
+
+// daysBetween returns the number of days from a to b.
+func daysBetween(a Date, b Date) int {
+	return int(b.Time(time.UTC).Sub(a.Time(time.UTC)).Hours() / 24)
+}
@@ -0,0 +1,62 @@
+package gocronometer_test
+
+import (
+	"github.com/burke/gocronometer"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBiometricRecords_WeightTrend(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: day, Metric: "Weight", Unit: "kg", Amount: 80},
+		{Day: day.AddDays(3), Metric: "weight", Unit: "kg", Amount: 81},
+		{Day: day.AddDays(3), Metric: "Weight", Unit: "kg", Amount: 83},
+		{Day: day.AddDays(3), Metric: "Weight", Unit: "lbs", Amount: 180},
+		{Day: day.AddDays(1), Metric: "Heart Rate", Unit: "bpm", Amount: 60},
+	}
+
+	points := biometrics.WeightTrend(nil)
+	if len(points) != 4 {
+		t.Fatalf("expected a point for each of the 4 days but found %+v", points)
+	}
+
+	// The gap is interpolated towards the average of 82 kg on the last day.
+	expected := []float64{80, 80.66666666666667, 81.33333333333333, 82}
+	trend := 80.0
+	for i, p := range points {
+		if p.Day != day.AddDays(i) || math.Abs(p.Amount-expected[i]) > 1e-9 {
+			t.Fatalf("unexpected point %d: %+v", i, p)
+		}
+		if i > 0 {
+			trend += 0.1 * (expected[i] - trend)
+		}
+		if math.Abs(p.Trend-trend) > 1e-9 {
+			t.Fatalf("expected a trend of %f on day %d but found %f", trend, i, p.Trend)
+		}
+		if p.Interpolated != (i == 1 || i == 2) {
+			t.Fatalf("unexpected interpolation of point %d: %+v", i, p)
+		}
+	}
+}
+
+func TestBiometricRecords_Trend_MaxGapDays(t *testing.T) {
+	day := gocronometer.Date{Year: 2021, Month: time.June, Day: 1}
+	biometrics := gocronometer.BiometricRecords{
+		{Day: day, Metric: "Weight", Unit: "kg", Amount: 80},
+		{Day: day.AddDays(1), Metric: "Weight", Unit: "kg", Amount: 82},
+		{Day: day.AddDays(10), Metric: "Weight", Unit: "kg", Amount: 78},
+	}
+
+	points := biometrics.Trend("Weight", &gocronometer.TrendOptions{Smoothing: 0.5, MaxGapDays: 7})
+	if len(points) != 3 {
+		t.Fatalf("expected the gap to be skipped but found %+v", points)
+	}
+	if points[1].Trend != 81 {
+		t.Fatalf("expected a trend of 81 with a smoothing of 0.5 but found %f", points[1].Trend)
+	}
+	if points[2].Trend != 78 || points[2].Interpolated {
+		t.Fatalf("expected the trend to restart after the gap but found %+v", points[2])
+	}
+}
@@ -0,0 +1,242 @@
+// Package vcr records the responses of Cronometer to a cassette file and replays them, so applications built on the
+// client can have deterministic integration tests without real credentials:
+//
+//	// Once, with real credentials.
+//	recorder := vcr.NewRecorder(nil)
+//	client := gocronometer.NewClient(&gocronometer.ClientOptions{HTTPClient: &http.Client{Transport: recorder}})
+//	...
+//	err := recorder.Save("testdata/export.json")
+//
+//	// In the tests.
+//	replayer, err := vcr.Load("testdata/export.json")
+//	client := gocronometer.NewClient(&gocronometer.ClientOptions{HTTPClient: &http.Client{Transport: replayer}})
+//
+// Request bodies are never recorded, so passwords are not written to the cassette, and requests are matched without
+// the export token and session nonce, which change on every login. The recorded responses hold the exported diary,
+// which should be reviewed before committing a cassette.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrNoInteraction is returned, wrapped, by a Replayer for a request that is not in its cassette or whose
+// interactions were all replayed.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Request identifies a recorded request.
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// Query is the encoded query without the export token.
+	Query string `json:"query,omitempty"`
+
+	// Procedure is the GWT procedure called, such as "generateAuthorizationToken", for requests to the GWT API.
+	Procedure string `json:"procedure,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette holds the interactions in the order they were recorded.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper recording the interactions of the requests it sends. It is safe for concurrent
+// use.
+type Recorder struct {
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates a Recorder sending the requests with the transport. A nil transport uses
+// http.DefaultTransport.
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, body, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	//noinspection GoUnhandledErrorResult
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  request,
+		Response: Response{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: string(respBody)},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save writes the interactions recorded so far to the cassette file at the path.
+func (r *Recorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.Cassette(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// Replayer is an http.RoundTripper answering requests with the responses of a cassette instead of sending them. Each
+// interaction is replayed once, in the order recorded among the interactions matching the request. It is safe for
+// concurrent use.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewReplayer creates a Replayer of the cassette.
+func NewReplayer(cassette Cassette) *Replayer {
+	return &Replayer{cassette: cassette, replayed: make([]bool, len(cassette.Interactions))}
+}
+
+// Load creates a Replayer of the cassette file at the path.
+func Load(path string) (*Replayer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("decoding cassette: %w", err)
+	}
+	return NewReplayer(cassette), nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, _, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || interaction.Request != request {
+			continue
+		}
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%s %s: %w", request.Method, request.Path, ErrNoInteraction)
+}
+
+// Remaining returns the number of interactions that have not been replayed.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	remaining := 0
+	for _, replayed := range r.replayed {
+		if !replayed {
+			remaining++
+		}
+	}
+	return remaining
+}
+
+// newRequest identifies the request, returning its body when it was consumed to find the GWT procedure.
+func newRequest(req *http.Request) (Request, []byte, error) {
+	query := req.URL.Query()
+	query.Del("nonce")
+
+	request := Request{Method: req.Method, Path: req.URL.Path, Query: query.Encode()}
+	if req.Body == nil || req.Body == http.NoBody {
+		return request, nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return Request{}, nil, fmt.Errorf("reading request: %w", err)
+	}
+	//noinspection GoUnhandledErrorResult
+	req.Body.Close()
+
+	request.Procedure = gwtProcedure(string(body))
+	return request, body, nil
+}
+
+// gwtProcedure returns the procedure of a GWT request body, or an empty string for other bodies. The body starts
+// with the version, flags and the size of the string table, followed by the strings: the module base, the policy
+// hash, the service and the procedure.
+func gwtProcedure(body string) string {
+	fields := strings.Split(body, "|")
+	if len(fields) < 7 || fields[0] != "7" {
+		return ""
+	}
+	return fields[6]
+}
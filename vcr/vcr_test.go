@@ -0,0 +1,77 @@
+package vcr_test
+
+import (
+	"context"
+	"errors"
+	"github.com/burke/gocronometer"
+	"github.com/burke/gocronometer/vcr"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeCronometer answers token requests with a new token each time and exports with a servings CSV.
+type fakeCronometer struct {
+	tokens int
+}
+
+func (f *fakeCronometer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := "Day,Time,Food Name\n2021-06-01,08:00,Eggs\n"
+	if req.Method == http.MethodPost {
+		f.tokens++
+		body = `//OK[1,["token` + strings.Repeat("x", f.tokens) + `"],0,7]`
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}, Request: req}, nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := vcr.NewRecorder(&fakeCronometer{})
+	client := gocronometer.NewClient(&gocronometer.ClientOptions{HTTPClient: &http.Client{Transport: recorder}})
+	recorded, err := client.ExportServingsParsed(ctx, day, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cassette := recorder.Cassette()
+	if len(cassette.Interactions) != 2 || cassette.Interactions[0].Request.Procedure != "generateAuthorizationToken" {
+		t.Fatalf("unexpected cassette: %+v", cassette)
+	}
+	if strings.Contains(cassette.Interactions[1].Request.Query, "nonce") {
+		t.Fatalf("expected the token to be removed from the query: %s", cassette.Interactions[1].Request.Query)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "CronometerService") {
+		t.Fatalf("expected the request bodies not to be recorded: %s", data)
+	}
+
+	replayer, err := vcr.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = gocronometer.NewClient(&gocronometer.ClientOptions{HTTPClient: &http.Client{Transport: replayer}})
+	replayed, err := client.ExportServingsParsed(ctx, day, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !replayed.Equal(recorded) || replayer.Remaining() != 0 {
+		t.Fatalf("expected the recorded servings %+v but found %+v", recorded, replayed)
+	}
+
+	if _, err := client.ExportServings(ctx, day, day); !errors.Is(err, vcr.ErrNoInteraction) {
+		t.Fatalf("expected the interactions to be exhausted but found %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+package gocronometer
+
+import (
+	"github.com/burke/gocronometer/conversions"
+)
+
+// VitaminDUg returns the vitamin D of the serving in µg. Cronometer exports vitamin D in IU while targets are commonly
+// expressed in µg.
+func (s ServingRecord) VitaminDUg() float64 {
+	return conversions.VitaminDIUToUg(s.VitaminDUI)
+}
+
+// VitaminAIU returns the vitamin A of the serving in IU, assuming it is all retinol. Cronometer exports vitamin A in
+// µg RAE, which is the unit of most targets, so this is only needed for comparing with older IU based labels.
+func (s ServingRecord) VitaminAIU() float64 {
+	return conversions.VitaminAUgRAEToIU(s.VitaminAUg, conversions.Retinol)
+}